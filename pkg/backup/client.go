@@ -0,0 +1,16 @@
+package backup
+
+import (
+	"context"
+
+	everestv1alpha "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/percona-everest-backend/client"
+)
+
+// everestClientConnector exposes the subset of the Everest API client that
+// the backup command needs.
+type everestClientConnector interface {
+	CreateBackup(ctx context.Context, kubernetesID string, body client.DatabaseClusterBackup) (*client.DatabaseClusterBackup, error)
+	GetBackup(ctx context.Context, kubernetesID, name string) (*client.DatabaseClusterBackup, error)
+	CreateBackupSchedule(ctx context.Context, kubernetesID string, body everestv1alpha.BackupSchedule) (*everestv1alpha.BackupSchedule, error)
+}
@@ -0,0 +1,236 @@
+// Package backup holds the main logic for backup commands.
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	everestv1alpha "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/percona-everest-backend/client"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+// pollInterval is how often we check the status of a running backup.
+const pollInterval = 5 * time.Second
+
+// MySQL implements logic for the backup command.
+type MySQL struct {
+	config        *MySQLConfig
+	everestClient everestClientConnector
+	kubeClient    *kubernetes.Kubernetes
+	l             *logrus.Entry
+}
+
+// MySQLConfig stores configuration for the backup command.
+type MySQLConfig struct {
+	Name         string
+	ClusterName  string `mapstructure:"cluster-name"`
+	KubernetesID string `mapstructure:"kubernetes-id"`
+	// Namespace defines the namespace the cluster runs in. Only needed when
+	// Local is set.
+	Namespace string
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// StorageSecretName points at the secret in the same namespace holding
+	// the object-storage credentials (S3, GCS or Azure Blob). With Local set,
+	// the name is used as the rclone remote name and the secret is not read.
+	StorageSecretName string `mapstructure:"storage-secret-name"`
+
+	// Schedule is a cron spec. When set, a BackupSchedule CR is created
+	// instead of a one-off DatabaseClusterBackup.
+	Schedule string
+	// Retention is the number of backups a schedule shall keep.
+	Retention int
+
+	// Local runs mysqldump directly against the cluster and streams it to
+	// storage, bypassing the operator-managed DatabaseClusterBackup flow.
+	// Mutually exclusive with Schedule.
+	Local bool
+}
+
+// NewMySQL returns a new MySQL struct.
+func NewMySQL(c *MySQLConfig, everestClient everestClientConnector, kubeClient *kubernetes.Kubernetes) *MySQL {
+	if c == nil {
+		logrus.Panic("MySQLConfig is required")
+	}
+
+	cli := &MySQL{
+		config:        c,
+		everestClient: everestClient,
+		kubeClient:    kubeClient,
+		l:             logrus.WithField("component", "backup/mysql"),
+	}
+
+	return cli
+}
+
+// Run runs the backup command.
+func (m *MySQL) Run(ctx context.Context) error {
+	if m.config.Local && m.config.Schedule != "" {
+		return errors.New("local and schedule are mutually exclusive")
+	}
+
+	if m.config.Local {
+		return m.runLocalDump(ctx)
+	}
+
+	if m.config.Schedule != "" {
+		return m.runSchedule(ctx)
+	}
+
+	return m.runOnDemand(ctx)
+}
+
+// runLocalDump streams a mysqldump of the cluster straight to storage,
+// without going through the operator-managed DatabaseClusterBackup flow.
+func (m *MySQL) runLocalDump(ctx context.Context) error {
+	m.l.Infof("Streaming local dump of %q to %q", m.config.ClusterName, m.config.StorageSecretName)
+
+	secret, err := m.kubeClient.GetSecret(ctx, m.config.ClusterName+"-secrets", m.config.Namespace)
+	if err != nil {
+		return errors.Wrap(err, "cannot get user secret")
+	}
+
+	localPort, closeFn, err := m.kubeClient.PortForward(ctx, m.config.Namespace, m.config.ClusterName, 3306)
+	if err != nil {
+		return errors.Wrap(err, "cannot port-forward to cluster")
+	}
+	defer closeFn()
+
+	dumpArgs := []string{"mysqldump", "-h", "127.0.0.1", "-P", fmt.Sprintf("%d", localPort), "-u", "root", "--all-databases"}
+	dumpEnv := []string{"MYSQL_PWD=" + string(secret.Data["root"])}
+	remote := fmt.Sprintf("%s:%s/%s", m.config.StorageSecretName, m.config.ClusterName, m.config.Name)
+
+	if err := m.streamDump(ctx, dumpArgs, dumpEnv, remote); err != nil {
+		return errors.Wrap(err, "cannot stream local dump")
+	}
+
+	m.l.Infof("Local dump of %q has been uploaded to %q", m.config.ClusterName, remote)
+
+	return nil
+}
+
+func (m *MySQL) runOnDemand(ctx context.Context) error {
+	m.l.Infof("Requesting backup %q for cluster %q", m.config.Name, m.config.ClusterName)
+
+	payload := m.preparePayload()
+	backup, err := m.everestClient.CreateBackup(ctx, m.config.KubernetesID, *payload)
+	if err != nil {
+		return errors.Wrap(err, "cannot create backup")
+	}
+
+	return m.waitForCompletion(ctx, backup.Name)
+}
+
+func (m *MySQL) runSchedule(ctx context.Context) error {
+	m.l.Infof("Creating backup schedule %q for cluster %q", m.config.Name, m.config.ClusterName)
+
+	payload := everestv1alpha.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: m.config.Name,
+		},
+		Spec: everestv1alpha.BackupScheduleSpec{
+			Schedule:          m.config.Schedule,
+			Retention:         m.config.Retention,
+			ClusterName:       m.config.ClusterName,
+			StorageSecretName: m.config.StorageSecretName,
+		},
+	}
+
+	if _, err := m.everestClient.CreateBackupSchedule(ctx, m.config.KubernetesID, payload); err != nil {
+		return errors.Wrap(err, "cannot create backup schedule")
+	}
+
+	m.l.Infof("Backup schedule %q has been created", m.config.Name)
+
+	return nil
+}
+
+func (m *MySQL) preparePayload() *client.DatabaseClusterBackup {
+	return &client.DatabaseClusterBackup{
+		Name:              m.config.Name,
+		ClusterName:       m.config.ClusterName,
+		StorageSecretName: m.config.StorageSecretName,
+	}
+}
+
+// waitForCompletion blocks until the backup reaches a terminal status.
+func (m *MySQL) waitForCompletion(ctx context.Context, name string) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			backup, err := m.everestClient.GetBackup(ctx, m.config.KubernetesID, name)
+			if err != nil {
+				return errors.Wrap(err, "cannot get backup status")
+			}
+
+			switch backup.Status {
+			case client.MysqlBackupStatusCompleted:
+				m.l.Infof("Backup %q completed", name)
+				return nil
+			case client.MysqlBackupStatusFailed:
+				return fmt.Errorf("backup %q failed", name)
+			default:
+				m.l.Debugf("Backup %q is %s, waiting", name, backup.Status)
+			}
+		}
+	}
+}
+
+// streamDump pipes a mysqldump/xtrabackup stream through gzip into the configured
+// remote (S3, GCS or Azure Blob) using an rclone-style remote config. It is used
+// for ad-hoc dumps that bypass the operator-managed DatabaseClusterBackup flow.
+// dumpEnv is appended to the dump process's environment rather than being
+// embedded in dumpArgs, so credentials don't end up readable via ps(1) or
+// /proc/<pid>/cmdline.
+func (m *MySQL) streamDump(ctx context.Context, dumpArgs, dumpEnv []string, remote string) error {
+	dump := exec.CommandContext(ctx, dumpArgs[0], dumpArgs[1:]...) //nolint:gosec
+	dump.Env = append(os.Environ(), dumpEnv...)
+	gzip := exec.CommandContext(ctx, "gzip")
+	upload := exec.CommandContext(ctx, "rclone", "rcat", remote)
+
+	var err error
+	gzip.Stdin, err = dump.StdoutPipe()
+	if err != nil {
+		return errors.Wrap(err, "cannot pipe dump into gzip")
+	}
+
+	upload.Stdin, err = gzip.StdoutPipe()
+	if err != nil {
+		return errors.Wrap(err, "cannot pipe gzip into uploader")
+	}
+
+	if err := upload.Start(); err != nil {
+		return errors.Wrap(err, "cannot start uploader")
+	}
+	if err := gzip.Start(); err != nil {
+		return errors.Wrap(err, "cannot start gzip")
+	}
+	if err := dump.Run(); err != nil {
+		return errors.Wrap(err, "cannot run dump command")
+	}
+
+	// The uploader must finish reading everything gzip produced before we
+	// consider it done, so wait on it last.
+	if err := gzip.Wait(); err != nil {
+		return errors.Wrap(err, "gzip failed")
+	}
+
+	return errors.Wrap(upload.Wait(), "upload failed")
+}
@@ -0,0 +1,14 @@
+// Package connect holds the main logic for connect commands.
+package connect
+
+import (
+	"context"
+
+	"github.com/percona/percona-everest-backend/client"
+)
+
+// everestClientConnector exposes the subset of the Everest API client that
+// the connect commands need.
+type everestClientConnector interface {
+	GetDBCluster(ctx context.Context, kubernetesID, name string) (*client.DatabaseCluster, error)
+}
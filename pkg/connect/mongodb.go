@@ -0,0 +1,180 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	everestv1alpha "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/percona-everest-backend/client"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+// mongoshCredentialsScript is the template for the temporary mongosh init
+// script written by writeMongoshCredentialsFile. Credentials are passed this
+// way, rather than on the mongosh command line, so they are not readable by
+// any local user via ps(1) or /proc/<pid>/cmdline.
+const mongoshCredentialsScript = `db = connect(%q);
+db.auth(%q, %q);
+`
+
+// MongoDB implements logic for the connect command.
+type MongoDB struct {
+	config        *MongoDBConfig
+	everestClient everestClientConnector
+	kubeClient    *kubernetes.Kubernetes
+	l             *logrus.Entry
+}
+
+// MongoDBConfig stores configuration for the connect command.
+type MongoDBConfig struct {
+	Name         string
+	KubernetesID string `mapstructure:"kubernetes-id"`
+	// Namespace defines the namespace the cluster runs in.
+	Namespace string
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// Local sets up a port-forward to one of the cluster's Pods instead of
+	// relying on the mongos/replset Service already being reachable.
+	Local bool
+	// PrintDSN makes Run print the connection URI instead of execing into
+	// mongosh.
+	PrintDSN bool `mapstructure:"print-dsn"`
+}
+
+// NewMongoDB returns a new MongoDB struct.
+func NewMongoDB(c *MongoDBConfig, everestClient everestClientConnector, kubeClient *kubernetes.Kubernetes) *MongoDB {
+	if c == nil {
+		logrus.Panic("MongoDBConfig is required")
+	}
+
+	cli := &MongoDB{
+		config:        c,
+		everestClient: everestClient,
+		kubeClient:    kubeClient,
+		l:             logrus.WithField("component", "connect/mongodb"),
+	}
+
+	return cli
+}
+
+// Run runs the connect command.
+func (m *MongoDB) Run(ctx context.Context) error {
+	cluster, err := m.everestClient.GetDBCluster(ctx, m.config.KubernetesID, m.config.Name)
+	if err != nil {
+		return errors.Wrap(err, "cannot get database cluster")
+	}
+
+	secret, err := m.kubeClient.GetSecret(ctx, cluster.Name+"-secrets", m.config.Namespace)
+	if err != nil {
+		return errors.Wrap(err, "cannot get user secret")
+	}
+
+	host, port, closeFn, err := m.resolveAddress(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	if closeFn != nil {
+		defer closeFn()
+	}
+
+	user := "root"
+	password := string(secret.Data["password"])
+	dsn := fmt.Sprintf("mongodb://%s:%s@%s:%d/admin", user, password, host, port)
+
+	if m.config.PrintDSN {
+		fmt.Println(dsn) //nolint:forbidigo
+		return nil
+	}
+
+	m.l.Infof("Connecting to %q", m.config.Name)
+
+	credsPath, cleanup, err := writeMongoshCredentialsFile(host, port, user, password)
+	if err != nil {
+		return errors.Wrap(err, "cannot write mongosh credentials file")
+	}
+	defer cleanup()
+
+	cmd := exec.CommandContext(ctx, "mongosh", "--shell", "--eval", fmt.Sprintf("load(%q)", credsPath))
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return errors.Wrap(cmd.Run(), "cannot exec mongosh client")
+}
+
+// writeMongoshCredentialsFile writes a mongosh init script that connects to
+// host:port and authenticates as user, without ever putting the password on
+// the mongosh command line. The caller must call the returned cleanup func
+// once the client has started.
+func writeMongoshCredentialsFile(host string, port int, user, password string) (string, func(), error) {
+	f, err := os.CreateTemp("", "everest-mongosh-*.js")
+	if err != nil {
+		return "", nil, errors.Wrap(err, "cannot create temp file")
+	}
+	cleanup := func() { os.Remove(f.Name()) } //nolint:errcheck
+
+	if err := f.Chmod(0o600); err != nil {
+		cleanup()
+		return "", nil, errors.Wrap(err, "cannot chmod temp file")
+	}
+
+	uri := fmt.Sprintf("mongodb://%s:%d/admin", host, port)
+	script := fmt.Sprintf(mongoshCredentialsScript, uri, user, password)
+
+	if _, err := f.WriteString(script); err != nil {
+		f.Close() //nolint:errcheck
+		cleanup()
+		return "", nil, errors.Wrap(err, "cannot write temp file")
+	}
+
+	if err := f.Close(); err != nil {
+		cleanup()
+		return "", nil, errors.Wrap(err, "cannot close temp file")
+	}
+
+	return f.Name(), cleanup, nil
+}
+
+// resolveAddress decides between the internal mongos/replset ClusterIP and
+// the external LB/Ingress address, optionally setting up a port-forward
+// when Local is requested.
+func (m *MongoDB) resolveAddress(ctx context.Context, cluster *client.DatabaseCluster) (string, int, func(), error) {
+	port := 27017
+
+	if m.config.Local {
+		localPort, closeFn, err := m.kubeClient.PortForward(ctx, m.config.Namespace, cluster.Name, port)
+		if err != nil {
+			return "", 0, nil, errors.Wrap(err, "cannot port-forward to cluster")
+		}
+
+		return "127.0.0.1", localPort, closeFn, nil
+	}
+
+	if cluster.Spec.Proxy.Expose.Type == everestv1alpha.ExposeTypeExternal {
+		host, externalPort, err := m.kubeClient.GetExternalAddress(ctx, m.config.Namespace, cluster.Name)
+		if err != nil {
+			return "", 0, nil, errors.Wrap(err, "cannot get external address")
+		}
+
+		return host, int(externalPort), nil, nil
+	}
+
+	// Unsharded clusters have no mongos router and are reached through the
+	// replica set Service.
+	service := "mongos"
+	if cluster.Spec.Proxy.Type != everestv1alpha.ProxyTypeMongos {
+		service = "rs0"
+	}
+	host := fmt.Sprintf("%s-%s.%s.svc.cluster.local", cluster.Name, service, m.config.Namespace)
+
+	return host, port, nil, nil
+}
@@ -0,0 +1,136 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	everestv1alpha "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/percona-everest-backend/client"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+// MySQL implements logic for the connect command.
+type MySQL struct {
+	config        *MySQLConfig
+	everestClient everestClientConnector
+	kubeClient    *kubernetes.Kubernetes
+	l             *logrus.Entry
+}
+
+// MySQLConfig stores configuration for the connect command.
+type MySQLConfig struct {
+	Name         string
+	KubernetesID string `mapstructure:"kubernetes-id"`
+	// Namespace defines the namespace the cluster runs in.
+	Namespace string
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// Local sets up a port-forward to one of the cluster's Pods instead of
+	// relying on the cluster's Service already being reachable.
+	Local bool
+	// PrintDSN makes Run print the connection URI instead of execing into
+	// the mysql client.
+	PrintDSN bool `mapstructure:"print-dsn"`
+}
+
+// NewMySQL returns a new MySQL struct.
+func NewMySQL(c *MySQLConfig, everestClient everestClientConnector, kubeClient *kubernetes.Kubernetes) *MySQL {
+	if c == nil {
+		logrus.Panic("MySQLConfig is required")
+	}
+
+	cli := &MySQL{
+		config:        c,
+		everestClient: everestClient,
+		kubeClient:    kubeClient,
+		l:             logrus.WithField("component", "connect/mysql"),
+	}
+
+	return cli
+}
+
+// Run runs the connect command.
+func (m *MySQL) Run(ctx context.Context) error {
+	cluster, err := m.everestClient.GetDBCluster(ctx, m.config.KubernetesID, m.config.Name)
+	if err != nil {
+		return errors.Wrap(err, "cannot get database cluster")
+	}
+
+	secret, err := m.kubeClient.GetSecret(ctx, cluster.Name+"-secrets", m.config.Namespace)
+	if err != nil {
+		return errors.Wrap(err, "cannot get user secret")
+	}
+
+	host, port, closeFn, err := m.resolveAddress(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	if closeFn != nil {
+		defer closeFn()
+	}
+
+	user := "root"
+	password := string(secret.Data["root"])
+	dsn := fmt.Sprintf("mysql://%s:%s@%s:%d/", user, password, host, port)
+
+	if m.config.PrintDSN {
+		fmt.Println(dsn) //nolint:forbidigo
+		return nil
+	}
+
+	m.l.Infof("Connecting to %q", m.config.Name)
+
+	cmd := exec.CommandContext(ctx, "mysql",
+		"-h", host,
+		"-P", fmt.Sprintf("%d", port),
+		"-u", user,
+	)
+	// Pass the password via MYSQL_PWD rather than -p, which would otherwise
+	// be readable by any local user via ps(1) or /proc/<pid>/cmdline.
+	cmd.Env = append(os.Environ(), "MYSQL_PWD="+password)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return errors.Wrap(cmd.Run(), "cannot exec mysql client")
+}
+
+// resolveAddress decides between the internal ClusterIP and the external
+// LB/Ingress address based on the cluster's proxy expose type, optionally
+// setting up a port-forward when Local is requested. When a port-forward is
+// set up it returns a cleanup function that must be called once the
+// connection is no longer needed; otherwise the cleanup function is nil.
+func (m *MySQL) resolveAddress(ctx context.Context, cluster *client.DatabaseCluster) (string, int, func(), error) {
+	port := 3306
+
+	if m.config.Local {
+		localPort, closeFn, err := m.kubeClient.PortForward(ctx, m.config.Namespace, cluster.Name, port)
+		if err != nil {
+			return "", 0, nil, errors.Wrap(err, "cannot port-forward to cluster")
+		}
+
+		return "127.0.0.1", localPort, closeFn, nil
+	}
+
+	if cluster.Spec.Proxy.Expose.Type == everestv1alpha.ExposeTypeExternal {
+		host, externalPort, err := m.kubeClient.GetExternalAddress(ctx, m.config.Namespace, cluster.Name)
+		if err != nil {
+			return "", 0, nil, errors.Wrap(err, "cannot get external address")
+		}
+
+		return host, int(externalPort), nil, nil
+	}
+
+	host := fmt.Sprintf("%s-haproxy.%s.svc.cluster.local", cluster.Name, m.config.Namespace)
+
+	return host, port, nil, nil
+}
@@ -0,0 +1,133 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	everestv1alpha "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/percona-everest-backend/client"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+// PostgreSQL implements logic for the connect command.
+type PostgreSQL struct {
+	config        *PostgreSQLConfig
+	everestClient everestClientConnector
+	kubeClient    *kubernetes.Kubernetes
+	l             *logrus.Entry
+}
+
+// PostgreSQLConfig stores configuration for the connect command.
+type PostgreSQLConfig struct {
+	Name         string
+	KubernetesID string `mapstructure:"kubernetes-id"`
+	// Namespace defines the namespace the cluster runs in.
+	Namespace string
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// Local sets up a port-forward to one of the cluster's Pods instead of
+	// relying on the PGBouncer Service already being reachable.
+	Local bool
+	// PrintDSN makes Run print the connection URI instead of execing into
+	// the psql client.
+	PrintDSN bool `mapstructure:"print-dsn"`
+}
+
+// NewPostgreSQL returns a new PostgreSQL struct.
+func NewPostgreSQL(c *PostgreSQLConfig, everestClient everestClientConnector, kubeClient *kubernetes.Kubernetes) *PostgreSQL {
+	if c == nil {
+		logrus.Panic("PostgreSQLConfig is required")
+	}
+
+	cli := &PostgreSQL{
+		config:        c,
+		everestClient: everestClient,
+		kubeClient:    kubeClient,
+		l:             logrus.WithField("component", "connect/postgresql"),
+	}
+
+	return cli
+}
+
+// Run runs the connect command.
+func (p *PostgreSQL) Run(ctx context.Context) error {
+	cluster, err := p.everestClient.GetDBCluster(ctx, p.config.KubernetesID, p.config.Name)
+	if err != nil {
+		return errors.Wrap(err, "cannot get database cluster")
+	}
+
+	secret, err := p.kubeClient.GetSecret(ctx, cluster.Name+"-secrets", p.config.Namespace)
+	if err != nil {
+		return errors.Wrap(err, "cannot get user secret")
+	}
+
+	host, port, closeFn, err := p.resolveAddress(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	if closeFn != nil {
+		defer closeFn()
+	}
+
+	user := "postgres"
+	password := string(secret.Data["password"])
+	dsn := fmt.Sprintf("postgresql://%s:%s@%s:%d/postgres", user, password, host, port)
+
+	if p.config.PrintDSN {
+		fmt.Println(dsn) //nolint:forbidigo
+		return nil
+	}
+
+	p.l.Infof("Connecting to %q", p.config.Name)
+
+	// Pass the password via PGPASSWORD and keep it out of the connection
+	// string psql is invoked with, which would otherwise be readable by any
+	// local user via ps(1) or /proc/<pid>/cmdline.
+	argDSN := fmt.Sprintf("postgresql://%s@%s:%d/postgres", user, host, port)
+
+	cmd := exec.CommandContext(ctx, "psql", argDSN)
+	cmd.Env = append(os.Environ(), "PGPASSWORD="+password)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return errors.Wrap(cmd.Run(), "cannot exec psql client")
+}
+
+// resolveAddress decides between the internal PGBouncer ClusterIP and the
+// external LB/Ingress address, optionally setting up a port-forward when
+// Local is requested.
+func (p *PostgreSQL) resolveAddress(ctx context.Context, cluster *client.DatabaseCluster) (string, int, func(), error) {
+	port := 5432
+
+	if p.config.Local {
+		localPort, closeFn, err := p.kubeClient.PortForward(ctx, p.config.Namespace, cluster.Name, port)
+		if err != nil {
+			return "", 0, nil, errors.Wrap(err, "cannot port-forward to cluster")
+		}
+
+		return "127.0.0.1", localPort, closeFn, nil
+	}
+
+	if cluster.Spec.Proxy.Expose.Type == everestv1alpha.ExposeTypeExternal {
+		host, externalPort, err := p.kubeClient.GetExternalAddress(ctx, p.config.Namespace, cluster.Name)
+		if err != nil {
+			return "", 0, nil, errors.Wrap(err, "cannot get external address")
+		}
+
+		return host, int(externalPort), nil, nil
+	}
+
+	host := fmt.Sprintf("%s-pgbouncer.%s.svc.cluster.local", cluster.Name, p.config.Namespace)
+
+	return host, port, nil, nil
+}
@@ -0,0 +1,74 @@
+package delete //nolint:predeclared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// MongoDB implements logic for the MongoDB command.
+type MongoDB struct {
+	config        *MongoDBConfig
+	everestClient everestClientConnector
+	l             *logrus.Entry
+}
+
+// MongoDBConfig stores configuration for the MongoDB command.
+type MongoDBConfig struct {
+	Name         string
+	KubernetesID string `mapstructure:"kubernetes-id"`
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// Force is true when we shall not prompt for removal.
+	Force bool
+}
+
+// NewMongoDB returns a new MongoDB struct.
+func NewMongoDB(c *MongoDBConfig, everestClient everestClientConnector) *MongoDB {
+	if c == nil {
+		logrus.Panic("MongoDBConfig is required")
+	}
+
+	cli := &MongoDB{
+		config:        c,
+		everestClient: everestClient,
+		l:             logrus.WithField("component", "delete/mongodb"),
+	}
+
+	return cli
+}
+
+// Run runs the MongoDB command.
+func (m *MongoDB) Run(ctx context.Context) error {
+	if !m.config.Force {
+		confirm := &survey.Confirm{
+			Message: fmt.Sprintf("Are you sure you want to remove the %q database cluster?", m.config.Name),
+		}
+		prompt := false
+		err := survey.AskOne(confirm, &prompt)
+		if err != nil {
+			return err
+		}
+
+		if !prompt {
+			m.l.Info("Exiting")
+			return nil
+		}
+	}
+
+	m.l.Infof("Deleting %q cluster", m.config.Name)
+	_, err := m.everestClient.DeleteDBCluster(ctx, m.config.KubernetesID, m.config.Name)
+	if err != nil {
+		return err
+	}
+
+	m.l.Infof("Cluster %q successfully deleted", m.config.Name)
+
+	return nil
+}
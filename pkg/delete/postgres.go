@@ -0,0 +1,74 @@
+package delete //nolint:predeclared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// PostgreSQL implements logic for the PostgreSQL command.
+type PostgreSQL struct {
+	config        *PostgreSQLConfig
+	everestClient everestClientConnector
+	l             *logrus.Entry
+}
+
+// PostgreSQLConfig stores configuration for the PostgreSQL command.
+type PostgreSQLConfig struct {
+	Name         string
+	KubernetesID string `mapstructure:"kubernetes-id"`
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// Force is true when we shall not prompt for removal.
+	Force bool
+}
+
+// NewPostgreSQL returns a new PostgreSQL struct.
+func NewPostgreSQL(c *PostgreSQLConfig, everestClient everestClientConnector) *PostgreSQL {
+	if c == nil {
+		logrus.Panic("PostgreSQLConfig is required")
+	}
+
+	cli := &PostgreSQL{
+		config:        c,
+		everestClient: everestClient,
+		l:             logrus.WithField("component", "delete/postgresql"),
+	}
+
+	return cli
+}
+
+// Run runs the PostgreSQL command.
+func (p *PostgreSQL) Run(ctx context.Context) error {
+	if !p.config.Force {
+		confirm := &survey.Confirm{
+			Message: fmt.Sprintf("Are you sure you want to remove the %q database cluster?", p.config.Name),
+		}
+		prompt := false
+		err := survey.AskOne(confirm, &prompt)
+		if err != nil {
+			return err
+		}
+
+		if !prompt {
+			p.l.Info("Exiting")
+			return nil
+		}
+	}
+
+	p.l.Infof("Deleting %q cluster", p.config.Name)
+	_, err := p.everestClient.DeleteDBCluster(ctx, p.config.KubernetesID, p.config.Name)
+	if err != nil {
+		return err
+	}
+
+	p.l.Infof("Cluster %q successfully deleted", p.config.Name)
+
+	return nil
+}
@@ -0,0 +1,15 @@
+// Package describe holds the main logic for describe commands.
+package describe
+
+import (
+	"context"
+
+	"github.com/percona/percona-everest-backend/client"
+)
+
+// everestClientConnector exposes the subset of the Everest API client that
+// the describe commands need.
+type everestClientConnector interface {
+	GetDBCluster(ctx context.Context, kubernetesID, name string) (*client.DatabaseCluster, error)
+	ListBackups(ctx context.Context, kubernetesID, clusterName string) (*client.DatabaseClusterBackupList, error)
+}
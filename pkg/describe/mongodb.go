@@ -0,0 +1,103 @@
+package describe
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+// MongoDB implements logic for the describe command.
+type MongoDB struct {
+	config        *MongoDBConfig
+	everestClient everestClientConnector
+	kubeClient    *kubernetes.Kubernetes
+	l             *logrus.Entry
+}
+
+// MongoDBConfig stores configuration for the describe command.
+type MongoDBConfig struct {
+	Name         string
+	KubernetesID string `mapstructure:"kubernetes-id"`
+	// Namespace defines the namespace the cluster runs in.
+	Namespace string
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// Output selects the rendering format: "", "json" or "yaml".
+	Output string
+	// ShowCredentials resolves and prints the database password instead of
+	// only the secret name.
+	ShowCredentials bool `mapstructure:"show-credentials"`
+}
+
+// NewMongoDB returns a new MongoDB struct.
+func NewMongoDB(c *MongoDBConfig, everestClient everestClientConnector, kubeClient *kubernetes.Kubernetes) *MongoDB {
+	if c == nil {
+		logrus.Panic("MongoDBConfig is required")
+	}
+
+	cli := &MongoDB{
+		config:        c,
+		everestClient: everestClient,
+		kubeClient:    kubeClient,
+		l:             logrus.WithField("component", "describe/mongodb"),
+	}
+
+	return cli
+}
+
+// Run runs the describe command and returns the rendered output.
+func (m *MongoDB) Run(ctx context.Context) (string, error) {
+	cluster, err := m.everestClient.GetDBCluster(ctx, m.config.KubernetesID, m.config.Name)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get database cluster")
+	}
+
+	backups, err := m.everestClient.ListBackups(ctx, m.config.KubernetesID, m.config.Name)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot list backups")
+	}
+
+	// "-rs0" is the PSMDB replica set StatefulSet itself, as opposed to the
+	// "-mongos" router Deployment that fronts sharded topologies.
+	events, err := m.kubeClient.GetEvents(ctx, m.config.Namespace, cluster.Name+"-rs0", maxEvents)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get events")
+	}
+
+	pvcs, err := m.kubeClient.GetPVCs(ctx, m.config.Namespace, cluster.Name)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get pvc usage")
+	}
+
+	out := Output{
+		Name:                  cluster.Name,
+		Engine:                string(cluster.Spec.Engine.Type),
+		Replicas:              cluster.Spec.Engine.Replicas,
+		Ready:                 cluster.Status.Ready,
+		CPU:                   cluster.Spec.Engine.Resources.CPU.String(),
+		Memory:                cluster.Spec.Engine.Resources.Memory.String(),
+		Disk:                  cluster.Spec.Engine.Storage.Size.String(),
+		Endpoints:             cluster.Status.Hostnames,
+		CredentialsSecretName: cluster.Name + "-secrets",
+		Backups:               backupSummaries(backups),
+		PVCs:                  pvcUsage(pvcs),
+		Events:                eventSummaries(events),
+	}
+
+	if m.config.ShowCredentials {
+		secret, err := m.kubeClient.GetSecret(ctx, out.CredentialsSecretName, m.config.Namespace)
+		if err != nil {
+			return "", errors.Wrap(err, "cannot resolve credentials")
+		}
+		out.Password = string(secret.Data["password"])
+	}
+
+	return out.Render(m.config.Output)
+}
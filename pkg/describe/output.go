@@ -0,0 +1,160 @@
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/percona/percona-everest-backend/client"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Output stores the human- and machine-readable view of a single cluster,
+// assembled from the Everest API and the underlying Kubernetes objects.
+type Output struct {
+	Name      string   `json:"name"`
+	Engine    string   `json:"engine"`
+	Replicas  int32    `json:"replicas"`
+	Ready     int32    `json:"ready"`
+	CPU       string   `json:"cpu"`
+	Memory    string   `json:"memory"`
+	Disk      string   `json:"disk"`
+	Endpoints []string `json:"endpoints"`
+
+	CredentialsSecretName string `json:"credentialsSecretName"`
+	Password              string `json:"password,omitempty"`
+
+	Backups []BackupSummary `json:"backups"`
+	PVCs    []PVCUsage      `json:"pvcs"`
+	Events  []EventSummary  `json:"events"`
+}
+
+// BackupSummary is a condensed view of a single backup.
+type BackupSummary struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"createdAt"`
+	SizeBytes int64  `json:"sizeBytes"`
+}
+
+// PVCUsage reports the capacity of a single PVC.
+type PVCUsage struct {
+	Name     string `json:"name"`
+	Capacity string `json:"capacity"`
+}
+
+// EventSummary is a condensed view of a single Kubernetes event.
+type EventSummary struct {
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+	Time    string `json:"time"`
+}
+
+// maxEvents is the number of recent events shown for the StatefulSet.
+const maxEvents = 10
+
+// String renders the Output as the default human-readable block.
+func (o Output) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Name:      %s\n", o.Name)
+	fmt.Fprintf(&b, "Engine:    %s\n", o.Engine)
+	fmt.Fprintf(&b, "Replicas:  %d/%d ready\n", o.Ready, o.Replicas)
+	fmt.Fprintf(&b, "Resources: cpu=%s memory=%s disk=%s\n", o.CPU, o.Memory, o.Disk)
+	fmt.Fprintf(&b, "Endpoints: %s\n", strings.Join(o.Endpoints, ", "))
+
+	fmt.Fprintf(&b, "\nCredentials:\n  secret: %s\n", o.CredentialsSecretName)
+	if o.Password != "" {
+		fmt.Fprintf(&b, "  password: %s\n", o.Password)
+	}
+
+	fmt.Fprintf(&b, "\nBackups:\n")
+	for _, backup := range o.Backups {
+		fmt.Fprintf(&b, "  - %s [%s] %s (%d bytes)\n", backup.Name, backup.Status, backup.CreatedAt, backup.SizeBytes)
+	}
+
+	fmt.Fprintf(&b, "\nPVC usage:\n")
+	for _, pvc := range o.PVCs {
+		fmt.Fprintf(&b, "  - %s: %s\n", pvc.Name, pvc.Capacity)
+	}
+
+	fmt.Fprintf(&b, "\nRecent events:\n")
+	for _, event := range o.Events {
+		fmt.Fprintf(&b, "  - [%s] %s: %s\n", event.Time, event.Reason, event.Message)
+	}
+
+	return b.String()
+}
+
+// Render formats the Output according to the requested format: "", "json"
+// or "yaml". An empty format falls back to the human-readable block.
+func (o Output) Render(format string) (string, error) {
+	switch format {
+	case "":
+		return o.String(), nil
+	case "json":
+		out, err := json.MarshalIndent(o, "", "  ")
+		if err != nil {
+			return "", errors.Wrap(err, "cannot marshal output to json")
+		}
+		return string(out), nil
+	case "yaml":
+		out, err := yaml.Marshal(o)
+		if err != nil {
+			return "", errors.Wrap(err, "cannot marshal output to yaml")
+		}
+		return string(out), nil
+	default:
+		return "", fmt.Errorf("unsupported output format %q", format)
+	}
+}
+
+func backupSummaries(backups *client.DatabaseClusterBackupList) []BackupSummary {
+	if backups == nil || backups.Items == nil {
+		return nil
+	}
+
+	out := make([]BackupSummary, 0, len(*backups.Items))
+	for _, b := range *backups.Items {
+		out = append(out, BackupSummary{
+			Name:      b.Name,
+			Status:    string(b.Status),
+			CreatedAt: b.CreatedAt,
+			SizeBytes: b.SizeBytes,
+		})
+	}
+
+	return out
+}
+
+func pvcUsage(pvcs []corev1.PersistentVolumeClaim) []PVCUsage {
+	out := make([]PVCUsage, 0, len(pvcs))
+	for _, pvc := range pvcs {
+		capacity := pvc.Status.Capacity[corev1.ResourceStorage]
+		out = append(out, PVCUsage{
+			Name:     pvc.Name,
+			Capacity: capacity.String(),
+		})
+	}
+
+	return out
+}
+
+func eventSummaries(events []corev1.Event) []EventSummary {
+	if len(events) > maxEvents {
+		events = events[len(events)-maxEvents:]
+	}
+
+	out := make([]EventSummary, 0, len(events))
+	for _, e := range events {
+		out = append(out, EventSummary{
+			Reason:  e.Reason,
+			Message: e.Message,
+			Time:    e.LastTimestamp.String(),
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,104 @@
+package describe
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+// PostgreSQL implements logic for the describe command.
+type PostgreSQL struct {
+	config        *PostgreSQLConfig
+	everestClient everestClientConnector
+	kubeClient    *kubernetes.Kubernetes
+	l             *logrus.Entry
+}
+
+// PostgreSQLConfig stores configuration for the describe command.
+type PostgreSQLConfig struct {
+	Name         string
+	KubernetesID string `mapstructure:"kubernetes-id"`
+	// Namespace defines the namespace the cluster runs in.
+	Namespace string
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// Output selects the rendering format: "", "json" or "yaml".
+	Output string
+	// ShowCredentials resolves and prints the database password instead of
+	// only the secret name.
+	ShowCredentials bool `mapstructure:"show-credentials"`
+}
+
+// NewPostgreSQL returns a new PostgreSQL struct.
+func NewPostgreSQL(c *PostgreSQLConfig, everestClient everestClientConnector, kubeClient *kubernetes.Kubernetes) *PostgreSQL {
+	if c == nil {
+		logrus.Panic("PostgreSQLConfig is required")
+	}
+
+	cli := &PostgreSQL{
+		config:        c,
+		everestClient: everestClient,
+		kubeClient:    kubeClient,
+		l:             logrus.WithField("component", "describe/postgresql"),
+	}
+
+	return cli
+}
+
+// Run runs the describe command and returns the rendered output.
+func (p *PostgreSQL) Run(ctx context.Context) (string, error) {
+	cluster, err := p.everestClient.GetDBCluster(ctx, p.config.KubernetesID, p.config.Name)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get database cluster")
+	}
+
+	backups, err := p.everestClient.ListBackups(ctx, p.config.KubernetesID, p.config.Name)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot list backups")
+	}
+
+	// Events come from the PostgreSQL StatefulSet itself, not the pgbouncer
+	// proxy in front of it -- that's where restart/crash/scheduling events
+	// surface.
+	events, err := p.kubeClient.GetEvents(ctx, p.config.Namespace, cluster.Name+"-pg", maxEvents)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get events")
+	}
+
+	pvcs, err := p.kubeClient.GetPVCs(ctx, p.config.Namespace, cluster.Name)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get pvc usage")
+	}
+
+	out := Output{
+		Name:                  cluster.Name,
+		Engine:                string(cluster.Spec.Engine.Type),
+		Replicas:              cluster.Spec.Engine.Replicas,
+		Ready:                 cluster.Status.Ready,
+		CPU:                   cluster.Spec.Engine.Resources.CPU.String(),
+		Memory:                cluster.Spec.Engine.Resources.Memory.String(),
+		Disk:                  cluster.Spec.Engine.Storage.Size.String(),
+		Endpoints:             cluster.Status.Hostnames,
+		CredentialsSecretName: cluster.Name + "-secrets",
+		Backups:               backupSummaries(backups),
+		PVCs:                  pvcUsage(pvcs),
+		Events:                eventSummaries(events),
+	}
+
+	if p.config.ShowCredentials {
+		secret, err := p.kubeClient.GetSecret(ctx, out.CredentialsSecretName, p.config.Namespace)
+		if err != nil {
+			return "", errors.Wrap(err, "cannot resolve credentials")
+		}
+		out.Password = string(secret.Data["password"])
+	}
+
+	return out.Render(p.config.Output)
+}
@@ -0,0 +1,135 @@
+// Package install holds the main logic for install and uninstall commands.
+package install
+
+import (
+	"context"
+	"embed"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+//go:embed deploy/*
+var deployFS embed.FS
+
+// fieldManager is the server-side-apply field manager used for every
+// resource this package applies, so re-running Install acts as an upgrade
+// rather than a conflict.
+const fieldManager = "everest-cli"
+
+// step is a single ordered unit of the install/uninstall flow.
+type step struct {
+	// name describes the step for logging.
+	name string
+	// files are embedded manifest paths applied (or, in reverse, deleted)
+	// as part of this step.
+	files []string
+	// crdNames are the CRDs introduced by this step. Install waits for
+	// them to reach the Established condition before moving on.
+	crdNames []string
+}
+
+// steps is the fixed, ordered install plan: namespaces, then CRDs (waiting
+// for Established), then RBAC, then operators, then the Everest backend.
+var steps = []step{ //nolint:gochecknoglobals
+	{name: "namespaces", files: []string{"deploy/namespaces.yaml"}},
+	{
+		name: "crds",
+		files: []string{
+			"deploy/crds/databaseclusters.yaml",
+			"deploy/crds/databaseclusterbackups.yaml",
+		},
+		crdNames: []string{
+			"databaseclusters.everest.percona.com",
+			"databaseclusterbackups.everest.percona.com",
+		},
+	},
+	{name: "rbac", files: []string{"deploy/rbac.yaml"}},
+	{name: "operators", files: []string{"deploy/operators.yaml"}},
+	{name: "everest-backend", files: []string{"deploy/quickstart-k8s.yaml"}},
+}
+
+// Install implements logic for the install command.
+type Install struct {
+	config InstallConfig
+	l      *zap.SugaredLogger
+
+	kubeClient *kubernetes.Kubernetes
+}
+
+// InstallConfig stores configuration for the install command.
+type InstallConfig struct {
+	// KubeconfigPath is a path to a kubeconfig.
+	KubeconfigPath string `mapstructure:"kubeconfig"`
+	// DryRun prints the ordered manifest list instead of applying it.
+	DryRun bool `mapstructure:"dry-run"`
+}
+
+// NewInstall returns a new Install struct.
+func NewInstall(c InstallConfig, l *zap.SugaredLogger) (*Install, error) {
+	cli := &Install{
+		config: c,
+		l:      l.With("component", "install"),
+	}
+
+	if c.DryRun {
+		return cli, nil
+	}
+
+	k, err := kubernetes.New(c.KubeconfigPath, cli.l)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not connect to Kubernetes"))
+	}
+	cli.kubeClient = k
+
+	return cli, nil
+}
+
+// Run runs the install command.
+func (i *Install) Run(ctx context.Context) error {
+	if i.config.DryRun {
+		for _, name := range orderedManifestNames() {
+			fmt.Println(name) //nolint:forbidigo
+		}
+		return nil
+	}
+
+	for _, s := range steps {
+		i.l.Infof("Applying %s", s.name)
+
+		for _, file := range s.files {
+			manifest, err := deployFS.ReadFile(file)
+			if err != nil {
+				return errors.Join(err, fmt.Errorf("could not read embedded manifest %q", file))
+			}
+
+			if err := i.kubeClient.ApplyManifest(ctx, manifest, fieldManager); err != nil {
+				return errors.Join(err, fmt.Errorf("could not apply manifest %q", file))
+			}
+		}
+
+		for _, crdName := range s.crdNames {
+			if err := i.kubeClient.WaitForCRDEstablished(ctx, crdName); err != nil {
+				return errors.Join(err, fmt.Errorf("CRD %q did not become established", crdName))
+			}
+		}
+	}
+
+	i.l.Info("Everest has been installed")
+
+	return nil
+}
+
+// orderedManifestNames returns every embedded manifest path in the order
+// Install.Run applies them.
+func orderedManifestNames() []string {
+	names := make([]string, 0)
+	for _, s := range steps {
+		names = append(names, s.files...)
+	}
+
+	return names
+}
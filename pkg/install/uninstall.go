@@ -0,0 +1,88 @@
+package install
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+// Uninstall implements logic for the uninstall command.
+type Uninstall struct {
+	config UninstallConfig
+	l      *zap.SugaredLogger
+
+	kubeClient *kubernetes.Kubernetes
+}
+
+// UninstallConfig stores configuration for the uninstall command.
+type UninstallConfig struct {
+	// KubeconfigPath is a path to a kubeconfig.
+	KubeconfigPath string `mapstructure:"kubeconfig"`
+	// DryRun prints the ordered manifest list instead of deleting it.
+	DryRun bool `mapstructure:"dry-run"`
+}
+
+// NewUninstall returns a new Uninstall struct.
+func NewUninstall(c UninstallConfig, l *zap.SugaredLogger) (*Uninstall, error) {
+	cli := &Uninstall{
+		config: c,
+		l:      l.With("component", "uninstall"),
+	}
+
+	if c.DryRun {
+		return cli, nil
+	}
+
+	k, err := kubernetes.New(c.KubeconfigPath, cli.l)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not connect to Kubernetes"))
+	}
+	cli.kubeClient = k
+
+	return cli, nil
+}
+
+// Run runs the uninstall command, walking the install steps in reverse and
+// clearing finalizers so namespace-scoped resources (CRs left behind by
+// operators) don't block deletion.
+func (u *Uninstall) Run(ctx context.Context) error {
+	if u.config.DryRun {
+		names := orderedManifestNames()
+		for i := len(names) - 1; i >= 0; i-- {
+			fmt.Println(names[i]) //nolint:forbidigo
+		}
+		return nil
+	}
+
+	for i := len(steps) - 1; i >= 0; i-- {
+		s := steps[i]
+		u.l.Infof("Removing %s", s.name)
+
+		for j := len(s.files) - 1; j >= 0; j-- {
+			file := s.files[j]
+
+			manifest, err := deployFS.ReadFile(file)
+			if err != nil {
+				return errors.Join(err, fmt.Errorf("could not read embedded manifest %q", file))
+			}
+
+			if err := u.kubeClient.DeleteManifest(ctx, manifest); err != nil {
+				return errors.Join(err, fmt.Errorf("could not delete manifest %q", file))
+			}
+		}
+
+		for _, crdName := range s.crdNames {
+			if err := u.kubeClient.RemoveCRDFinalizers(ctx, crdName); err != nil {
+				return errors.Join(err, fmt.Errorf("could not clear finalizers for CRD %q", crdName))
+			}
+		}
+	}
+
+	u.l.Info("Everest has been uninstalled")
+
+	return nil
+}
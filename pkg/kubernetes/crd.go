@@ -0,0 +1,63 @@
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// crdEstablishTimeout bounds how long WaitForCRDEstablished polls before
+// giving up.
+const crdEstablishTimeout = 2 * time.Minute
+
+// crdPollInterval is how often WaitForCRDEstablished checks CRD status.
+const crdPollInterval = 2 * time.Second
+
+// WaitForCRDEstablished blocks until the named CustomResourceDefinition
+// reports its Established condition as True.
+func (k *Kubernetes) WaitForCRDEstablished(ctx context.Context, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, crdEstablishTimeout)
+	defer cancel()
+
+	err := wait.PollUntilContextCancel(ctx, crdPollInterval, true, func(ctx context.Context) (bool, error) {
+		crd, err := k.apiext.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err //nolint:wrapcheck
+		}
+
+		for _, cond := range crd.Status.Conditions {
+			if cond.Type == apiextv1.Established && cond.Status == apiextv1.ConditionTrue {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+
+	return errors.Wrapf(err, "CRD %q did not become established", name)
+}
+
+// RemoveCRDFinalizers clears the finalizers on the named CRD so it (and the
+// custom resources it governs) can be deleted even if the owning operator is
+// already gone.
+func (k *Kubernetes) RemoveCRDFinalizers(ctx context.Context, name string) error {
+	crds := k.apiext.ApiextensionsV1().CustomResourceDefinitions()
+
+	crd, err := crds.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "cannot get CRD %q", name)
+	}
+
+	if len(crd.Finalizers) == 0 {
+		return nil
+	}
+
+	crd.Finalizers = nil
+	_, err = crds.Update(ctx, crd, metav1.UpdateOptions{})
+
+	return errors.Wrapf(err, "cannot clear finalizers on CRD %q", name)
+}
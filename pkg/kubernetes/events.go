@@ -0,0 +1,32 @@
+package kubernetes
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetEvents returns up to limit of the most recent events involving the
+// object named name in namespace, oldest first.
+func (k *Kubernetes) GetEvents(ctx context.Context, namespace, name string, limit int) ([]corev1.Event, error) {
+	list, err := k.client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.name=" + name,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list events")
+	}
+
+	events := list.Items
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(&events[j].LastTimestamp)
+	})
+
+	if len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+
+	return events, nil
+}
@@ -0,0 +1,119 @@
+// Package kubernetes provides a thin wrapper around client-go used by the
+// CLI commands that talk to a target cluster directly, rather than through
+// the Everest backend API.
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiextclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/versioned"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	k8sclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Kubernetes wraps the client-go clients needed by the CLI.
+type Kubernetes struct {
+	client     k8sclient.Interface
+	apiext     apiextclientset.Interface
+	dynamic    dynamic.Interface
+	discovery  discovery.DiscoveryInterface
+	restConfig *rest.Config
+	l          *zap.SugaredLogger
+}
+
+// New returns a new Kubernetes client built from the kubeconfig at path.
+func New(kubeconfigPath string, l *zap.SugaredLogger) (*Kubernetes, error) {
+	cfg, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build kubeconfig")
+	}
+
+	client, err := k8sclient.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create Kubernetes client")
+	}
+
+	apiext, err := apiextclientset.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create apiextensions client")
+	}
+
+	dyn, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create dynamic client")
+	}
+
+	disc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create discovery client")
+	}
+
+	return &Kubernetes{
+		client:     client,
+		apiext:     apiext,
+		dynamic:    dyn,
+		discovery:  disc,
+		restConfig: cfg,
+		l:          l,
+	}, nil
+}
+
+// restMapper builds a fresh RESTMapper from the current API group resources.
+// It is not cached since ApplyManifest/DeleteManifest run infrequently and
+// CRDs installed earlier in the same run (e.g. by Install) must be visible.
+func (k *Kubernetes) restMapper() (meta.RESTMapper, error) {
+	groupResources, err := restmapper.GetAPIGroupResources(k.discovery)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get API group resources")
+	}
+
+	return restmapper.NewDiscoveryRESTMapper(groupResources), nil
+}
+
+// GetNamespace returns the namespace identified by name.
+func (k *Kubernetes) GetNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
+	ns, err := k.client.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get namespace")
+	}
+
+	return ns, nil
+}
+
+// SetSecret creates secret, or updates it in place if it already exists.
+func (k *Kubernetes) SetSecret(secret *corev1.Secret) error {
+	ctx := context.Background()
+	secrets := k.client.CoreV1().Secrets(secret.Namespace)
+
+	_, err := secrets.Get(ctx, secret.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err := secrets.Create(ctx, secret, metav1.CreateOptions{})
+		return errors.Wrap(err, "cannot create secret")
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot get secret")
+	}
+
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return errors.Wrap(err, "cannot update secret")
+}
+
+// GetSecret returns the secret identified by name in namespace.
+func (k *Kubernetes) GetSecret(ctx context.Context, name, namespace string) (*corev1.Secret, error) {
+	secret, err := k.client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get secret")
+	}
+
+	return secret, nil
+}
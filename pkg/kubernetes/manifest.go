@@ -0,0 +1,119 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+)
+
+// decodeManifest splits a (possibly multi-document) YAML manifest into
+// individual unstructured objects, skipping empty documents.
+func decodeManifest(manifest []byte) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096) //nolint:gomnd
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, errors.Wrap(err, "cannot decode manifest")
+		}
+
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// resourceInterface returns a dynamic client scoped to the right namespace
+// for namespaced resources, or the cluster scope otherwise.
+func (k *Kubernetes) resourceInterface(mapping *meta.RESTMapping, namespace string) dynamic.ResourceInterface {
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return k.dynamic.Resource(mapping.Resource).Namespace(namespace)
+	}
+
+	return k.dynamic.Resource(mapping.Resource)
+}
+
+// ApplyManifest server-side applies every object in manifest using
+// fieldManager, so re-applying the same manifest later acts as an upgrade
+// instead of a conflict.
+func (k *Kubernetes) ApplyManifest(ctx context.Context, manifest []byte, fieldManager string) error {
+	objects, err := decodeManifest(manifest)
+	if err != nil {
+		return err
+	}
+
+	mapper, err := k.restMapper()
+	if err != nil {
+		return err
+	}
+
+	force := true
+	for _, obj := range objects {
+		gvk := obj.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return errors.Wrapf(err, "cannot map %s %q", gvk.Kind, obj.GetName())
+		}
+
+		payload, err := obj.MarshalJSON()
+		if err != nil {
+			return errors.Wrapf(err, "cannot marshal %s %q", gvk.Kind, obj.GetName())
+		}
+
+		_, err = k.resourceInterface(mapping, obj.GetNamespace()).Patch(ctx, obj.GetName(), types.ApplyPatchType, payload, metav1.PatchOptions{
+			FieldManager: fieldManager,
+			Force:        &force,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "cannot apply %s %q", gvk.Kind, obj.GetName())
+		}
+	}
+
+	return nil
+}
+
+// DeleteManifest deletes every object in manifest, ignoring objects that are
+// already gone.
+func (k *Kubernetes) DeleteManifest(ctx context.Context, manifest []byte) error {
+	objects, err := decodeManifest(manifest)
+	if err != nil {
+		return err
+	}
+
+	mapper, err := k.restMapper()
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		gvk := obj.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return errors.Wrapf(err, "cannot map %s %q", gvk.Kind, obj.GetName())
+		}
+
+		err = k.resourceInterface(mapping, obj.GetNamespace()).Delete(ctx, obj.GetName(), metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot delete %s %q", gvk.Kind, obj.GetName())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// monitoringAgentImage and nodeExporterImage are the images deployed by
+// DeployMonitoringAgent.
+const (
+	monitoringAgentImage = "victoriametrics/vmagent:latest"
+	nodeExporterImage    = "prom/node-exporter:latest"
+)
+
+// monitoringDeploymentName returns the name of the vmagent/node-exporter
+// Deployment for clusterName.
+func monitoringDeploymentName(clusterName string) string {
+	return fmt.Sprintf("%s-monitoring-agent", clusterName)
+}
+
+// DeployMonitoringAgent deploys the VictoriaMetrics agent, with a
+// node-exporter sidecar, into namespace for the given cluster. Calling it
+// again updates the existing Deployment in place.
+func (k *Kubernetes) DeployMonitoringAgent(ctx context.Context, namespace, clusterName string) error {
+	name := monitoringDeploymentName(clusterName)
+	labels := map[string]string{
+		"app.kubernetes.io/name":     "monitoring-agent",
+		"app.kubernetes.io/instance": clusterName,
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "vmagent",
+							Image: monitoringAgentImage,
+							Args: []string{
+								"-remoteWrite.url=http://vmsingle." + namespace + ".svc.cluster.local:8429/api/v1/write",
+							},
+						},
+						{
+							Name:  "node-exporter",
+							Image: nodeExporterImage,
+							Ports: []corev1.ContainerPort{{ContainerPort: 9100}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	deployments := k.client.AppsV1().Deployments(namespace)
+
+	_, err := deployments.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err := deployments.Create(ctx, deployment, metav1.CreateOptions{})
+		return errors.Wrap(err, "cannot create monitoring agent deployment")
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot get monitoring agent deployment")
+	}
+
+	_, err = deployments.Update(ctx, deployment, metav1.UpdateOptions{})
+	return errors.Wrap(err, "cannot update monitoring agent deployment")
+}
+
+// RemoveMonitoringAgent deletes the Deployment created by
+// DeployMonitoringAgent for the given cluster, ignoring the case where it's
+// already gone.
+func (k *Kubernetes) RemoveMonitoringAgent(ctx context.Context, namespace, clusterName string) error {
+	name := monitoringDeploymentName(clusterName)
+
+	err := k.client.AppsV1().Deployments(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "cannot delete monitoring agent deployment")
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// PortForward opens a port-forward to the first Pod matching
+// "app.kubernetes.io/instance"=clusterName in namespace, forwarding
+// remotePort to a free local port. It returns the local port and a function
+// that tears the forward down once the caller is done with it.
+func (k *Kubernetes) PortForward(ctx context.Context, namespace, clusterName string, remotePort int) (int, func(), error) {
+	pods, err := k.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app.kubernetes.io/instance=" + clusterName,
+	})
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "cannot list pods")
+	}
+	if len(pods.Items) == 0 {
+		return 0, nil, fmt.Errorf("no pods found for cluster %q in namespace %q", clusterName, namespace)
+	}
+	podName := pods.Items[0].Name
+
+	localPort, err := freeLocalPort()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "cannot find a free local port")
+	}
+
+	url := k.client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(podName).
+		SubResource("portforward").
+		URL()
+
+	transport, upgrader, err := spdy.RoundTripperFor(k.restConfig)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "cannot build spdy round tripper")
+	}
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	stopCh := make(chan struct{}, 1)
+	readyCh := make(chan struct{})
+
+	fw, err := portforward.New(dialer,
+		[]string{fmt.Sprintf("%d:%d", localPort, remotePort)},
+		stopCh, readyCh, nil, nil)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "cannot create port-forwarder")
+	}
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- fw.ForwardPorts() }()
+
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		return 0, nil, errors.Wrap(err, "port-forward failed to start")
+	case <-ctx.Done():
+		close(stopCh)
+		return 0, nil, ctx.Err() //nolint:wrapcheck
+	}
+
+	return localPort, func() { close(stopCh) }, nil
+}
+
+// freeLocalPort asks the OS for an unused local TCP port.
+func freeLocalPort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+	defer l.Close() //nolint:errcheck
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
@@ -0,0 +1,22 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetPVCs returns the PersistentVolumeClaims belonging to clusterName in
+// namespace.
+func (k *Kubernetes) GetPVCs(ctx context.Context, namespace, clusterName string) ([]corev1.PersistentVolumeClaim, error) {
+	list, err := k.client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app.kubernetes.io/instance=" + clusterName,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list PVCs")
+	}
+
+	return list.Items, nil
+}
@@ -0,0 +1,72 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetExternalAddress returns the externally reachable host and port for the
+// Service named name in namespace. It prefers a LoadBalancer ingress
+// address, falling back to the first NodePort it finds.
+func (k *Kubernetes) GetExternalAddress(ctx context.Context, namespace, name string) (string, int32, error) {
+	svc, err := k.client.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, errors.Wrap(err, "cannot get service")
+	}
+
+	if len(svc.Spec.Ports) == 0 {
+		return "", 0, fmt.Errorf("service %q has no ports", name)
+	}
+
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		host := ingress.IP
+		if host == "" {
+			host = ingress.Hostname
+		}
+		if host != "" {
+			return host, svc.Spec.Ports[0].Port, nil
+		}
+	}
+
+	if svc.Spec.Type == corev1.ServiceTypeNodePort && svc.Spec.Ports[0].NodePort != 0 {
+		node, err := k.firstNodeAddress(ctx)
+		if err != nil {
+			return "", 0, err
+		}
+
+		return node, svc.Spec.Ports[0].NodePort, nil
+	}
+
+	return "", 0, fmt.Errorf("service %q has no externally reachable address", name)
+}
+
+// firstNodeAddress returns the first external (or, failing that, internal)
+// address reported by any node in the cluster.
+func (k *Kubernetes) firstNodeAddress(ctx context.Context) (string, error) {
+	nodes, err := k.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "cannot list nodes")
+	}
+
+	var internal string
+	for _, node := range nodes.Items {
+		for _, addr := range node.Status.Addresses {
+			if addr.Type == corev1.NodeExternalIP {
+				return addr.Address, nil
+			}
+			if addr.Type == corev1.NodeInternalIP && internal == "" {
+				internal = addr.Address
+			}
+		}
+	}
+
+	if internal != "" {
+		return internal, nil
+	}
+
+	return "", errors.New("no node addresses found")
+}
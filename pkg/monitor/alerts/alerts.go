@@ -0,0 +1,164 @@
+// Package alerts applies the default VMRule alerting rules for a cluster and
+// asks Alertmanager to pick them up.
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+// fieldManager is the server-side-apply field manager used for the VMRule
+// this package applies.
+const fieldManager = "everest-cli"
+
+// Rule is a single alerting rule. Rules are installed as a VMRule custom
+// resource, not pushed to Alertmanager directly -- Alertmanager only
+// receives already-fired alerts, it has no API to accept rule definitions.
+type Rule struct {
+	Alert       string
+	Expr        string
+	For         string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// DefaultRules is the ruleset applied unless the caller supplies its own.
+var DefaultRules = []Rule{ //nolint:gochecknoglobals
+	{
+		Alert:       "MySQLReplicationBroken",
+		Expr:        `mysql_slave_status_slave_io_running == 0 or mysql_slave_status_slave_sql_running == 0`,
+		For:         "5m",
+		Labels:      map[string]string{"severity": "critical"},
+		Annotations: map[string]string{"summary": "MySQL replication is broken on {{ $labels.instance }}"},
+	},
+	{
+		Alert:       "PGReplicationLag",
+		Expr:        `pg_replication_lag_seconds > 30`,
+		For:         "5m",
+		Labels:      map[string]string{"severity": "warning"},
+		Annotations: map[string]string{"summary": "PostgreSQL replication lag is above 30s on {{ $labels.instance }}"},
+	},
+	{
+		Alert:       "DiskFull",
+		Expr:        `node_filesystem_avail_bytes / node_filesystem_size_bytes < 0.1`,
+		For:         "10m",
+		Labels:      map[string]string{"severity": "critical"},
+		Annotations: map[string]string{"summary": "Disk on {{ $labels.instance }} is over 90% full"},
+	},
+}
+
+// Client applies alerting rules for a cluster.
+type Client struct {
+	kubeClient      *kubernetes.Kubernetes
+	alertmanagerURL string
+	httpClient      *http.Client
+}
+
+// New returns a new Client. alertmanagerURL may be empty, in which case
+// ApplyDefault skips the reload step.
+func New(kubeClient *kubernetes.Kubernetes, alertmanagerURL string) *Client {
+	return &Client{
+		kubeClient:      kubeClient,
+		alertmanagerURL: alertmanagerURL,
+		httpClient:      http.DefaultClient,
+	}
+}
+
+// ApplyDefault installs DefaultRules as a VMRule in namespace for
+// clusterName, then, when an Alertmanager URL is set, asks Alertmanager to
+// reload its configuration.
+func (c *Client) ApplyDefault(ctx context.Context, namespace, clusterName string) error {
+	return c.Apply(ctx, namespace, clusterName, DefaultRules)
+}
+
+// Apply installs rules as a VMRule in namespace for clusterName.
+func (c *Client) Apply(ctx context.Context, namespace, clusterName string, rules []Rule) error {
+	manifest, err := buildVMRuleManifest(namespace, clusterName, rules)
+	if err != nil {
+		return errors.Wrap(err, "cannot build VMRule manifest")
+	}
+
+	if err := c.kubeClient.ApplyManifest(ctx, manifest, fieldManager); err != nil {
+		return errors.Wrap(err, "cannot apply VMRule")
+	}
+
+	if c.alertmanagerURL == "" {
+		return nil
+	}
+
+	return c.reloadAlertmanager(ctx)
+}
+
+// reloadAlertmanager asks Alertmanager to reload its configuration without a
+// restart.
+func (c *Client) reloadAlertmanager(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.alertmanagerURL+"/-/reload", nil)
+	if err != nil {
+		return errors.Wrap(err, "cannot build reload request")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "cannot reload alertmanager")
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("alertmanager returned status %d while reloading", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// vmRuleTemplate renders a VMRule custom resource with one rule group
+// containing every rule.
+var vmRuleTemplate = template.Must(template.New("vmrule").Parse(`apiVersion: operator.victoriametrics.com/v1beta1
+kind: VMRule
+metadata:
+  name: {{ .ClusterName }}-default-alerts
+  namespace: {{ .Namespace }}
+spec:
+  groups:
+    - name: {{ .ClusterName }}-default
+      rules:
+{{- range .Rules }}
+        - alert: {{ .Alert }}
+          expr: {{ printf "%q" .Expr }}
+          for: {{ .For }}
+          labels:
+{{- range $k, $v := .Labels }}
+            {{ $k }}: {{ printf "%q" $v }}
+{{- end }}
+          annotations:
+{{- range $k, $v := .Annotations }}
+            {{ $k }}: {{ printf "%q" $v }}
+{{- end }}
+{{- end }}
+`))
+
+func buildVMRuleManifest(namespace, clusterName string, rules []Rule) ([]byte, error) {
+	var buf bytes.Buffer
+
+	data := struct {
+		Namespace   string
+		ClusterName string
+		Rules       []Rule
+	}{
+		Namespace:   namespace,
+		ClusterName: clusterName,
+		Rules:       rules,
+	}
+
+	if err := vmRuleTemplate.Execute(&buf, data); err != nil {
+		return nil, errors.Wrap(err, "cannot render VMRule template")
+	}
+
+	return buf.Bytes(), nil
+}
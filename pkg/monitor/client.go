@@ -0,0 +1,15 @@
+// Package monitor holds the main logic for monitor commands.
+package monitor
+
+import (
+	"context"
+
+	"github.com/percona/percona-everest-cli/pkg/monitor/connection"
+)
+
+// everestClientConnector exposes the subset of the Everest API client that
+// the monitor commands need.
+type everestClientConnector interface {
+	RegisterScrapeTarget(ctx context.Context, kubernetesID string, target connection.Target) error
+	DeregisterScrapeTarget(ctx context.Context, kubernetesID, jobName string) error
+}
@@ -0,0 +1,26 @@
+// Package connection builds the scrape target configuration used to
+// register a cluster's monitoring agent with the Everest backend.
+package connection
+
+import "fmt"
+
+// Target describes a Prometheus/VictoriaMetrics scrape target.
+type Target struct {
+	// JobName is the Prometheus job label for the scrape target.
+	JobName string `json:"jobName"`
+	// Address is the host:port of the VictoriaMetrics agent/pmm-client
+	// sidecar inside the cluster's monitoring namespace.
+	Address string `json:"address"`
+	// Namespace is the namespace the agent was deployed into.
+	Namespace string `json:"namespace"`
+}
+
+// New builds the Target for a given cluster, assuming the agent sidecar is
+// always exposed on the conventional metrics port.
+func New(clusterName, namespace string) Target {
+	return Target{
+		JobName:   fmt.Sprintf("%s-monitoring", clusterName),
+		Address:   fmt.Sprintf("%s-exporter.%s.svc.cluster.local:9100", clusterName, namespace),
+		Namespace: namespace,
+	}
+}
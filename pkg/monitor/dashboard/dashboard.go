@@ -0,0 +1,119 @@
+// Package dashboard uploads and removes the per-engine Grafana dashboards
+// bundled with the CLI.
+package dashboard
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+//go:embed dashboards/*.json
+var dashboards embed.FS
+
+// engineFiles maps an engine type to the dashboard JSON file shipped for it.
+var engineFiles = map[string]string{ //nolint:gochecknoglobals
+	"pxc":   "dashboards/pxc.json",
+	"psmdb": "dashboards/psmdb.json",
+	"pg":    "dashboards/pg.json",
+}
+
+// Client uploads/removes dashboards against a Grafana instance.
+type Client struct {
+	grafanaURL string
+	apiToken   string
+	httpClient *http.Client
+}
+
+// New returns a new Client.
+func New(grafanaURL, apiToken string) *Client {
+	return &Client{
+		grafanaURL: grafanaURL,
+		apiToken:   apiToken,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// UploadAll pushes every bundled dashboard to Grafana and returns the UIDs
+// that were created or updated.
+func (c *Client) UploadAll() ([]string, error) {
+	uids := make([]string, 0, len(engineFiles))
+	for engine, file := range engineFiles {
+		uid, err := c.upload(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot upload dashboard for %q", engine)
+		}
+		uids = append(uids, uid)
+	}
+
+	return uids, nil
+}
+
+func (c *Client) upload(file string) (string, error) {
+	raw, err := dashboards.ReadFile(file)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot read embedded dashboard")
+	}
+
+	var dash struct {
+		UID string `json:"uid"`
+	}
+	if err := json.Unmarshal(raw, &dash); err != nil {
+		return "", errors.Wrap(err, "cannot parse embedded dashboard")
+	}
+
+	body := fmt.Sprintf(`{"dashboard":%s,"overwrite":true}`, raw)
+	req, err := http.NewRequest(http.MethodPost, c.grafanaURL+"/api/dashboards/db", bytes.NewBufferString(body))
+	if err != nil {
+		return "", errors.Wrap(err, "cannot build request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot upload dashboard")
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("grafana returned status %d while uploading dashboard %q", resp.StatusCode, dash.UID)
+	}
+
+	return dash.UID, nil
+}
+
+// DeleteAll removes every bundled dashboard from Grafana by UID.
+func (c *Client) DeleteAll() error {
+	for engine, file := range engineFiles {
+		raw, err := dashboards.ReadFile(file)
+		if err != nil {
+			return errors.Wrapf(err, "cannot read embedded dashboard for %q", engine)
+		}
+
+		var dash struct {
+			UID string `json:"uid"`
+		}
+		if err := json.Unmarshal(raw, &dash); err != nil {
+			return errors.Wrapf(err, "cannot parse embedded dashboard for %q", engine)
+		}
+
+		req, err := http.NewRequest(http.MethodDelete, c.grafanaURL+"/api/dashboards/uid/"+dash.UID, nil)
+		if err != nil {
+			return errors.Wrap(err, "cannot build request")
+		}
+		req.Header.Set("Authorization", "Bearer "+c.apiToken)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return errors.Wrapf(err, "cannot delete dashboard %q", dash.UID)
+		}
+		resp.Body.Close() //nolint:errcheck
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+// Package datasource registers the VictoriaMetrics/Prometheus scrape
+// endpoint as a Grafana datasource.
+package datasource
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Client registers datasources against a Grafana instance.
+type Client struct {
+	grafanaURL string
+	apiToken   string
+	httpClient *http.Client
+}
+
+// New returns a new Client.
+func New(grafanaURL, apiToken string) *Client {
+	return &Client{
+		grafanaURL: grafanaURL,
+		apiToken:   apiToken,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// datasourcePayload mirrors the fields Grafana's datasource API expects.
+type datasourcePayload struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	URL       string `json:"url"`
+	Access    string `json:"access"`
+	IsDefault bool   `json:"isDefault"`
+}
+
+// Register creates the VictoriaMetrics datasource pointing at the given
+// Prometheus-remote-read-compatible URL. It does not update an existing
+// datasource: an error status from Grafana is returned as an error.
+func (c *Client) Register(name, url string) error {
+	payload := datasourcePayload{
+		Name:   name,
+		Type:   "prometheus",
+		URL:    url,
+		Access: "proxy",
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal datasource payload")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.grafanaURL+"/api/datasources", bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrap(err, "cannot build request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "cannot register datasource")
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("grafana returned status %d while registering datasource %q", resp.StatusCode, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package monitor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+	"github.com/percona/percona-everest-cli/pkg/monitor/connection"
+	"github.com/percona/percona-everest-cli/pkg/monitor/dashboard"
+)
+
+// Disable implements logic for the monitor disable command.
+type Disable struct {
+	config        *DisableConfig
+	everestClient everestClientConnector
+	kubeClient    *kubernetes.Kubernetes
+	l             *logrus.Entry
+}
+
+// DisableConfig stores configuration for the monitor disable command.
+type DisableConfig struct {
+	ClusterName  string `mapstructure:"cluster-name"`
+	KubernetesID string `mapstructure:"kubernetes-id"`
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// GrafanaURL and GrafanaAPIToken are used to remove the bundled
+	// per-engine dashboards that were pushed by monitor enable.
+	GrafanaURL      string `mapstructure:"grafana-url"`
+	GrafanaAPIToken string `mapstructure:"grafana-api-token"`
+}
+
+// NewDisable returns a new Disable struct.
+func NewDisable(c *DisableConfig, everestClient everestClientConnector, kubeClient *kubernetes.Kubernetes) *Disable {
+	if c == nil {
+		logrus.Panic("DisableConfig is required")
+	}
+
+	cli := &Disable{
+		config:        c,
+		everestClient: everestClient,
+		kubeClient:    kubeClient,
+		l:             logrus.WithField("component", "monitor/disable"),
+	}
+
+	return cli
+}
+
+// Run runs the monitor disable command.
+func (d *Disable) Run(ctx context.Context) error {
+	monitoringNamespace := d.config.KubernetesID + "-monitoring"
+
+	target := connection.New(d.config.ClusterName, monitoringNamespace)
+	d.l.Infof("Deregistering scrape target %q from Everest", target.JobName)
+	if err := d.everestClient.DeregisterScrapeTarget(ctx, d.config.KubernetesID, target.JobName); err != nil {
+		return errors.Wrap(err, "cannot deregister scrape target")
+	}
+
+	d.l.Infof("Removing monitoring agent from %q", monitoringNamespace)
+	if err := d.kubeClient.RemoveMonitoringAgent(ctx, monitoringNamespace, d.config.ClusterName); err != nil {
+		return errors.Wrap(err, "cannot remove monitoring agent")
+	}
+
+	if d.config.GrafanaURL != "" {
+		d.l.Info("Removing dashboards")
+		dashClient := dashboard.New(d.config.GrafanaURL, d.config.GrafanaAPIToken)
+		if err := dashClient.DeleteAll(); err != nil {
+			return errors.Wrap(err, "cannot remove dashboards")
+		}
+	}
+
+	d.l.Infof("Monitoring has been disabled for %q", d.config.ClusterName)
+
+	return nil
+}
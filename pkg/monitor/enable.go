@@ -0,0 +1,98 @@
+package monitor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+	"github.com/percona/percona-everest-cli/pkg/monitor/alerts"
+	"github.com/percona/percona-everest-cli/pkg/monitor/connection"
+	"github.com/percona/percona-everest-cli/pkg/monitor/dashboard"
+	"github.com/percona/percona-everest-cli/pkg/monitor/datasource"
+)
+
+// Enable implements logic for the monitor enable command.
+type Enable struct {
+	config        *EnableConfig
+	everestClient everestClientConnector
+	kubeClient    *kubernetes.Kubernetes
+	l             *logrus.Entry
+}
+
+// EnableConfig stores configuration for the monitor enable command.
+type EnableConfig struct {
+	ClusterName  string `mapstructure:"cluster-name"`
+	KubernetesID string `mapstructure:"kubernetes-id"`
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// GrafanaURL and GrafanaAPIToken are used to upload the bundled
+	// per-engine dashboards and register the datasource.
+	GrafanaURL      string `mapstructure:"grafana-url"`
+	GrafanaAPIToken string `mapstructure:"grafana-api-token"`
+
+	// AlertmanagerURL, when set, is asked to reload its configuration after
+	// the default alerting rules are applied as a VMRule. The rules are
+	// applied whether or not it is set.
+	AlertmanagerURL string `mapstructure:"alertmanager-url"`
+}
+
+// NewEnable returns a new Enable struct.
+func NewEnable(c *EnableConfig, everestClient everestClientConnector, kubeClient *kubernetes.Kubernetes) *Enable {
+	if c == nil {
+		logrus.Panic("EnableConfig is required")
+	}
+
+	cli := &Enable{
+		config:        c,
+		everestClient: everestClient,
+		kubeClient:    kubeClient,
+		l:             logrus.WithField("component", "monitor/enable"),
+	}
+
+	return cli
+}
+
+// Run runs the monitor enable command.
+func (e *Enable) Run(ctx context.Context) error {
+	monitoringNamespace := e.config.KubernetesID + "-monitoring"
+
+	e.l.Infof("Deploying monitoring agent into %q", monitoringNamespace)
+	if err := e.kubeClient.DeployMonitoringAgent(ctx, monitoringNamespace, e.config.ClusterName); err != nil {
+		return errors.Wrap(err, "cannot deploy monitoring agent")
+	}
+
+	target := connection.New(e.config.ClusterName, monitoringNamespace)
+	e.l.Infof("Registering scrape target %q with Everest", target.JobName)
+	if err := e.everestClient.RegisterScrapeTarget(ctx, e.config.KubernetesID, target); err != nil {
+		return errors.Wrap(err, "cannot register scrape target")
+	}
+
+	if e.config.GrafanaURL != "" {
+		e.l.Info("Uploading dashboards")
+		dashClient := dashboard.New(e.config.GrafanaURL, e.config.GrafanaAPIToken)
+		if _, err := dashClient.UploadAll(); err != nil {
+			return errors.Wrap(err, "cannot upload dashboards")
+		}
+
+		dsClient := datasource.New(e.config.GrafanaURL, e.config.GrafanaAPIToken)
+		if err := dsClient.Register(target.JobName, "http://"+target.Address); err != nil {
+			return errors.Wrap(err, "cannot register datasource")
+		}
+	}
+
+	e.l.Info("Applying default alerting rules")
+	alertsClient := alerts.New(e.kubeClient, e.config.AlertmanagerURL)
+	if err := alertsClient.ApplyDefault(ctx, monitoringNamespace, e.config.ClusterName); err != nil {
+		return errors.Wrap(err, "cannot apply alerting rules")
+	}
+
+	e.l.Infof("Monitoring has been enabled for %q", e.config.ClusterName)
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package password
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"hash"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// HashAlgorithm identifies one of the password hashing algorithms the reset
+// command can produce. It is stored verbatim in the secret's "algo" key so
+// the backend (or `password verify`) knows how to check a given hash
+// without needing to guess.
+type HashAlgorithm string
+
+// Supported hash algorithms.
+const (
+	HashAlgorithmPBKDF2SHA256 HashAlgorithm = "pbkdf2-sha256"
+	HashAlgorithmPBKDF2SHA512 HashAlgorithm = "pbkdf2-sha512"
+	HashAlgorithmArgon2id     HashAlgorithm = "argon2id"
+	HashAlgorithmBcrypt       HashAlgorithm = "bcrypt"
+)
+
+// HashCost holds the algorithm-specific cost parameters. Only the fields
+// relevant to the selected HashAlgorithm are used. It is stored as JSON in
+// the secret's "cost" key alongside the hash it produced, so verification
+// always uses the cost the hash was actually computed with, even after
+// DefaultHashCost changes.
+type HashCost struct {
+	// PBKDF2Iterations is the iteration count for pbkdf2-sha256/pbkdf2-sha512.
+	PBKDF2Iterations int `json:"pbkdf2Iterations,omitempty"`
+	// Argon2Time, Argon2MemoryKiB and Argon2Threads configure argon2id.
+	Argon2Time      uint32 `json:"argon2Time,omitempty"`
+	Argon2MemoryKiB uint32 `json:"argon2MemoryKiB,omitempty"`
+	Argon2Threads   uint8  `json:"argon2Threads,omitempty"`
+	// BcryptCost configures bcrypt.
+	BcryptCost int `json:"bcryptCost,omitempty"`
+}
+
+// DefaultHashCost returns reasonable cost parameters for every supported
+// algorithm, used when the caller doesn't override them.
+func DefaultHashCost() HashCost {
+	return HashCost{
+		PBKDF2Iterations: 4096,
+		Argon2Time:       1,
+		Argon2MemoryKiB:  64 * 1024, //nolint:gomnd
+		Argon2Threads:    4,
+		BcryptCost:       bcrypt.DefaultCost,
+	}
+}
+
+// hasher hashes and verifies a password under a specific algorithm.
+type hasher interface {
+	// hash derives a hash for password. salt is ignored by algorithms (like
+	// bcrypt) that manage their own salt internally.
+	hash(password string, salt []byte) ([]byte, error)
+	// verify reports whether password matches hash, given the same salt
+	// that was used to produce it.
+	verify(password string, hash, salt []byte) (bool, error)
+}
+
+// newHasher returns the hasher for algo.
+func newHasher(algo HashAlgorithm, cost HashCost) (hasher, error) {
+	switch algo {
+	case HashAlgorithmPBKDF2SHA256:
+		return pbkdf2Hasher{iterations: cost.PBKDF2Iterations, hashFunc: sha256.New, keyLen: sha256.Size}, nil
+	case HashAlgorithmPBKDF2SHA512:
+		return pbkdf2Hasher{iterations: cost.PBKDF2Iterations, hashFunc: sha512.New, keyLen: sha512.Size}, nil
+	case HashAlgorithmArgon2id:
+		return argon2idHasher{
+			time:    cost.Argon2Time,
+			memory:  cost.Argon2MemoryKiB,
+			threads: cost.Argon2Threads,
+			keyLen:  32, //nolint:gomnd
+		}, nil
+	case HashAlgorithmBcrypt:
+		return bcryptHasher{cost: cost.BcryptCost}, nil
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm %q", algo)
+	}
+}
+
+type pbkdf2Hasher struct {
+	iterations int
+	hashFunc   func() hash.Hash
+	keyLen     int
+}
+
+func (h pbkdf2Hasher) hash(password string, salt []byte) ([]byte, error) {
+	return pbkdf2.Key([]byte(password), salt, h.iterations, h.keyLen, h.hashFunc), nil
+}
+
+func (h pbkdf2Hasher) verify(password string, hash, salt []byte) (bool, error) {
+	computed, err := h.hash(password, salt)
+	if err != nil {
+		return false, err
+	}
+
+	return subtleConstantTimeCompare(computed, hash), nil
+}
+
+type argon2idHasher struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+	keyLen  uint32
+}
+
+func (h argon2idHasher) hash(password string, salt []byte) ([]byte, error) {
+	return argon2.IDKey([]byte(password), salt, h.time, h.memory, h.threads, h.keyLen), nil
+}
+
+func (h argon2idHasher) verify(password string, hash, salt []byte) (bool, error) {
+	computed, err := h.hash(password, salt)
+	if err != nil {
+		return false, err
+	}
+
+	return subtleConstantTimeCompare(computed, hash), nil
+}
+
+type bcryptHasher struct {
+	cost int
+}
+
+func (h bcryptHasher) hash(password string, _ []byte) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), h.cost)
+}
+
+func (h bcryptHasher) verify(password string, hash, _ []byte) (bool, error) {
+	err := bcrypt.CompareHashAndPassword(hash, []byte(password))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+// subtleConstantTimeCompare reports whether a and b are equal, in time
+// independent of their contents, to avoid timing attacks on verification.
+func subtleConstantTimeCompare(a, b []byte) bool {
+	return len(a) == len(b) && subtle.ConstantTimeCompare(a, b) == 1
+}
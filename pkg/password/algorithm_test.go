@@ -0,0 +1,66 @@
+package password
+
+import "testing"
+
+func TestHasherRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	algorithms := []HashAlgorithm{
+		HashAlgorithmPBKDF2SHA256,
+		HashAlgorithmPBKDF2SHA512,
+		HashAlgorithmArgon2id,
+		HashAlgorithmBcrypt,
+	}
+
+	cost := HashCost{
+		PBKDF2Iterations: 10,
+		Argon2Time:       1,
+		Argon2MemoryKiB:  8 * 1024, //nolint:gomnd
+		Argon2Threads:    1,
+		BcryptCost:       4,
+	}
+
+	for _, algo := range algorithms {
+		algo := algo
+
+		t.Run(string(algo), func(t *testing.T) {
+			t.Parallel()
+
+			h, err := newHasher(algo, cost)
+			if err != nil {
+				t.Fatalf("newHasher(%q): %v", algo, err)
+			}
+
+			salt := []byte("0123456789abcdef")
+
+			hash, err := h.hash("correct-password", salt)
+			if err != nil {
+				t.Fatalf("hash: %v", err)
+			}
+
+			ok, err := h.verify("correct-password", hash, salt)
+			if err != nil {
+				t.Fatalf("verify(correct): %v", err)
+			}
+			if !ok {
+				t.Error("verify(correct) = false, want true")
+			}
+
+			ok, err = h.verify("wrong-password", hash, salt)
+			if err != nil {
+				t.Fatalf("verify(wrong): %v", err)
+			}
+			if ok {
+				t.Error("verify(wrong) = true, want false")
+			}
+		})
+	}
+}
+
+func TestNewHasherUnsupportedAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	if _, err := newHasher(HashAlgorithm("does-not-exist"), DefaultHashCost()); err == nil {
+		t.Fatal("newHasher with an unsupported algorithm should return an error")
+	}
+}
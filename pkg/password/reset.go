@@ -18,15 +18,17 @@ package password
 
 import (
 	"context"
-	"crypto/sha256"
+	"crypto/rand"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/dchest/uniuri"
 	"go.uber.org/zap"
-	"golang.org/x/crypto/pbkdf2"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/percona/percona-everest-cli/pkg/kubernetes"
@@ -47,6 +49,21 @@ type (
 		KubeconfigPath string `mapstructure:"kubeconfig"`
 		// Namespace defines the namespace password shall be reset in.
 		Namespace string
+
+		// Algorithm selects the hashing algorithm used to store the new
+		// password. Defaults to HashAlgorithmPBKDF2SHA256.
+		Algorithm HashAlgorithm
+		// Cost overrides the default algorithm cost parameters.
+		Cost HashCost
+
+		// KeepPrevious writes the hash being replaced under
+		// "password.previous", annotated with an expiry, so in-flight
+		// requests verifying against the old password keep working during
+		// a rolling rotation.
+		KeepPrevious bool `mapstructure:"keep-previous"`
+		// PreviousTTL is how long the previous hash stays valid. Only used
+		// when KeepPrevious is set.
+		PreviousTTL time.Duration `mapstructure:"previous-ttl"`
 	}
 
 	// ResetResponse is a response from the reset command.
@@ -56,7 +73,20 @@ type (
 	}
 )
 
-const passwordSecretName = "everest-password"
+const (
+	passwordSecretName = "everest-password"
+
+	// saltLen is the number of random bytes used as a per-reset salt.
+	saltLen = 16
+
+	// previousExpiresAtAnnotation stores when the previous hash kept by
+	// --keep-previous stops being considered valid.
+	previousExpiresAtAnnotation = "everest.percona.com/password-previous-expires-at"
+
+	// defaultPreviousTTL is used when KeepPrevious is set but PreviousTTL
+	// isn't.
+	defaultPreviousTTL = 24 * time.Hour
+)
 
 func (r ResetResponse) String() string {
 	return fmt.Sprintf("Your new password is:\n%s", r.Password)
@@ -64,6 +94,10 @@ func (r ResetResponse) String() string {
 
 // NewReset returns a new Reset struct.
 func NewReset(c ResetConfig, l *zap.SugaredLogger) (*Reset, error) {
+	if c.Algorithm == "" {
+		c.Algorithm = HashAlgorithmPBKDF2SHA256
+	}
+
 	cli := &Reset{
 		config: c,
 		l:      l.With("component", "password/reset"),
@@ -85,16 +119,33 @@ func NewReset(c ResetConfig, l *zap.SugaredLogger) (*Reset, error) {
 
 // Run runs the reset command.
 func (r *Reset) Run(ctx context.Context) (*ResetResponse, error) {
-	ns, err := r.kubeClient.GetNamespace(ctx, r.config.Namespace)
+	cost := r.config.Cost
+	if cost == (HashCost{}) {
+		cost = DefaultHashCost()
+	}
+
+	h, err := newHasher(r.config.Algorithm, cost)
 	if err != nil {
-		return nil, errors.Join(err, errors.New("could not get namespace from Kubernetes"))
+		return nil, err
+	}
+
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Join(err, errors.New("could not generate salt"))
 	}
 
 	newPassword := uniuri.NewLen(128)
-	salt := []byte(ns.UID)
-	hash := pbkdf2.Key([]byte(newPassword), salt, 4096, 32, sha256.New)
+	newHash, err := h.hash(newPassword, salt)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not hash password"))
+	}
 
-	err = r.kubeClient.SetSecret(&corev1.Secret{
+	costJSON, err := json.Marshal(cost)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not marshal hash cost"))
+	}
+
+	secret := &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v1",
 			Kind:       "Secret",
@@ -105,12 +156,61 @@ func (r *Reset) Run(ctx context.Context) (*ResetResponse, error) {
 		},
 		Type: corev1.SecretTypeOpaque,
 		Data: map[string][]byte{
-			"password": hash,
+			"password": newHash,
+			"salt":     salt,
+			"algo":     []byte(r.config.Algorithm),
+			"cost":     costJSON,
 		},
-	})
-	if err != nil {
+	}
+
+	if r.config.KeepPrevious {
+		if err := r.carryOverPreviousHash(ctx, secret); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.kubeClient.SetSecret(secret); err != nil {
 		return nil, errors.Join(err, errors.New("could not update password in Kubernetes"))
 	}
 
 	return &ResetResponse{Password: newPassword}, nil
-}
\ No newline at end of file
+}
+
+// carryOverPreviousHash copies the currently-stored hash into
+// "password.previous"/"salt.previous"/"algo.previous" on secret, annotated
+// with an expiry, so rotations don't immediately break in-flight requests
+// that are still verifying against the old password.
+func (r *Reset) carryOverPreviousHash(ctx context.Context, secret *corev1.Secret) error {
+	current, err := r.kubeClient.GetSecret(ctx, passwordSecretName, r.config.Namespace)
+	if apierrors.IsNotFound(err) {
+		// Nothing to carry over on the very first reset.
+		return nil
+	}
+	if err != nil {
+		return errors.Join(err, errors.New("could not get current password secret"))
+	}
+
+	ttl := r.config.PreviousTTL
+	if ttl <= 0 {
+		ttl = defaultPreviousTTL
+	}
+
+	applyPreviousHash(secret, current, ttl, time.Now())
+
+	return nil
+}
+
+// applyPreviousHash copies the hash fields from current onto secret under
+// the ".previous" suffix and stamps previousExpiresAtAnnotation so
+// Verify.Run knows how long the carried-over hash stays valid.
+func applyPreviousHash(secret, current *corev1.Secret, ttl time.Duration, now time.Time) {
+	secret.Data["password.previous"] = current.Data["password"]
+	secret.Data["salt.previous"] = current.Data["salt"]
+	secret.Data["algo.previous"] = current.Data["algo"]
+	secret.Data["cost.previous"] = current.Data["cost"]
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[previousExpiresAtAnnotation] = now.Add(ttl).Format(time.RFC3339)
+}
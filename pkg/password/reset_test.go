@@ -0,0 +1,71 @@
+package password
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestApplyPreviousHash(t *testing.T) {
+	t.Parallel()
+
+	current := &corev1.Secret{
+		Data: map[string][]byte{
+			"password": []byte("old-hash"),
+			"salt":     []byte("old-salt"),
+			"algo":     []byte(HashAlgorithmBcrypt),
+			"cost":     []byte(`{"bcryptCost":10}`),
+		},
+	}
+
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"password": []byte("new-hash"),
+			"salt":     []byte("new-salt"),
+			"algo":     []byte(HashAlgorithmPBKDF2SHA256),
+			"cost":     []byte(`{"pbkdf2Iterations":4096}`),
+		},
+	}
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ttl := time.Hour
+
+	applyPreviousHash(secret, current, ttl, now)
+
+	if got, want := string(secret.Data["password.previous"]), "old-hash"; got != want {
+		t.Errorf("password.previous = %q, want %q", got, want)
+	}
+	if got, want := string(secret.Data["salt.previous"]), "old-salt"; got != want {
+		t.Errorf("salt.previous = %q, want %q", got, want)
+	}
+	if got, want := string(secret.Data["algo.previous"]), string(HashAlgorithmBcrypt); got != want {
+		t.Errorf("algo.previous = %q, want %q", got, want)
+	}
+	if got, want := string(secret.Data["cost.previous"]), `{"bcryptCost":10}`; got != want {
+		t.Errorf("cost.previous = %q, want %q", got, want)
+	}
+
+	wantExpiry := now.Add(ttl).Format(time.RFC3339)
+	if got := secret.Annotations[previousExpiresAtAnnotation]; got != wantExpiry {
+		t.Errorf("%s = %q, want %q", previousExpiresAtAnnotation, got, wantExpiry)
+	}
+
+	// The current hash itself must be left untouched.
+	if got, want := string(secret.Data["password"]), "new-hash"; got != want {
+		t.Errorf("password = %q, want %q", got, want)
+	}
+}
+
+func TestApplyPreviousHashInitializesAnnotations(t *testing.T) {
+	t.Parallel()
+
+	current := &corev1.Secret{Data: map[string][]byte{"password": []byte("old-hash")}}
+	secret := &corev1.Secret{Data: map[string][]byte{}}
+
+	applyPreviousHash(secret, current, time.Hour, time.Now())
+
+	if secret.Annotations == nil {
+		t.Fatal("Annotations is nil, want it to be initialized")
+	}
+}
@@ -0,0 +1,140 @@
+package password
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+// Verify implements the main logic for the verify command. It exits 0 when
+// the supplied password matches the stored hash and 1 otherwise, which
+// makes it usable from CI checks and cron-driven rotation enforcement.
+type Verify struct {
+	config VerifyConfig
+	l      *zap.SugaredLogger
+
+	kubeClient *kubernetes.Kubernetes
+}
+
+// VerifyConfig stores configuration for the verify command.
+type VerifyConfig struct {
+	// KubeconfigPath is a path to a kubeconfig.
+	KubeconfigPath string `mapstructure:"kubeconfig"`
+	// Namespace defines the namespace the password secret lives in.
+	Namespace string
+
+	// Password is the plain-text password to check against the stored hash.
+	Password string
+}
+
+// NewVerify returns a new Verify struct.
+func NewVerify(c VerifyConfig, l *zap.SugaredLogger) (*Verify, error) {
+	cli := &Verify{
+		config: c,
+		l:      l.With("component", "password/verify"),
+	}
+
+	k, err := kubernetes.New(c.KubeconfigPath, cli.l)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not connect to Kubernetes"))
+	}
+	cli.kubeClient = k
+
+	return cli, nil
+}
+
+// Run runs the verify command. It returns an error (and a non-zero exit
+// code) both when verification fails and when the stored hash could not be
+// read or checked at all.
+//
+// If the current hash doesn't match, and the secret carries a
+// "password.previous" hash from a --keep-previous reset that hasn't expired
+// yet (see previousExpiresAtAnnotation), the password is also checked
+// against that previous hash before verification is considered to have
+// failed.
+func (v *Verify) Run(ctx context.Context) error {
+	secret, err := v.kubeClient.GetSecret(ctx, passwordSecretName, v.config.Namespace)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get password secret"))
+	}
+
+	ok, err := v.verifyHash(secret.Data["algo"], secret.Data["cost"], secret.Data["password"], secret.Data["salt"])
+	if err != nil {
+		return err
+	}
+	if ok {
+		v.l.Info("Password verified")
+		return nil
+	}
+
+	ok, err = v.verifyPreviousHash(secret)
+	if err != nil {
+		return err
+	}
+	if ok {
+		v.l.Info("Password verified against previous hash")
+		return nil
+	}
+
+	return fmt.Errorf("password does not match")
+}
+
+// verifyPreviousHash checks config.Password against the "password.previous"
+// hash carried over by a --keep-previous reset, as long as
+// previousExpiresAtAnnotation hasn't passed yet. It returns false, nil (not
+// an error) whenever there's no unexpired previous hash to check against.
+func (v *Verify) verifyPreviousHash(secret *corev1.Secret) (bool, error) {
+	if len(secret.Data["password.previous"]) == 0 {
+		return false, nil
+	}
+
+	expiresAt, ok := secret.Annotations[previousExpiresAtAnnotation]
+	if !ok {
+		return false, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false, errors.Join(err, errors.New("could not parse previous hash expiry"))
+	}
+	if time.Now().After(t) {
+		return false, nil
+	}
+
+	return v.verifyHash(
+		secret.Data["algo.previous"], secret.Data["cost.previous"],
+		secret.Data["password.previous"], secret.Data["salt.previous"],
+	)
+}
+
+// verifyHash checks config.Password against a single stored hash/salt pair,
+// using algoRaw/costRaw to pick the hasher that produced it.
+func (v *Verify) verifyHash(algoRaw, costRaw, hash, salt []byte) (bool, error) {
+	algo := HashAlgorithm(algoRaw)
+
+	cost := DefaultHashCost()
+	if len(costRaw) > 0 {
+		if err := json.Unmarshal(costRaw, &cost); err != nil {
+			return false, errors.Join(err, errors.New("could not parse stored hash cost"))
+		}
+	}
+
+	h, err := newHasher(algo, cost)
+	if err != nil {
+		return false, err
+	}
+
+	ok, err := h.verify(v.config.Password, hash, salt)
+	if err != nil {
+		return false, errors.Join(err, errors.New("could not verify password"))
+	}
+
+	return ok, nil
+}
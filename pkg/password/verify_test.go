@@ -0,0 +1,117 @@
+package password
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func newVerifySecret(t *testing.T, password string) *corev1.Secret {
+	t.Helper()
+
+	h, err := newHasher(HashAlgorithmPBKDF2SHA256, DefaultHashCost())
+	if err != nil {
+		t.Fatalf("newHasher: %v", err)
+	}
+
+	salt := []byte("0123456789abcdef")
+
+	hash, err := h.hash(password, salt)
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+
+	costJSON, err := json.Marshal(DefaultHashCost())
+	if err != nil {
+		t.Fatalf("marshal cost: %v", err)
+	}
+
+	return &corev1.Secret{
+		Data: map[string][]byte{
+			"password": hash,
+			"salt":     salt,
+			"algo":     []byte(HashAlgorithmPBKDF2SHA256),
+			"cost":     costJSON,
+		},
+	}
+}
+
+func TestVerifyPreviousHash(t *testing.T) {
+	t.Parallel()
+
+	secret := newVerifySecret(t, "old-password")
+
+	v := &Verify{config: VerifyConfig{Password: "old-password"}}
+
+	t.Run("no previous hash present", func(t *testing.T) {
+		t.Parallel()
+
+		noPrevious := secret.DeepCopy()
+		ok, err := v.verifyPreviousHash(noPrevious)
+		if err != nil {
+			t.Fatalf("verifyPreviousHash: %v", err)
+		}
+		if ok {
+			t.Error("verifyPreviousHash() = true, want false when there's no previous hash")
+		}
+	})
+
+	withPrevious := func() *corev1.Secret {
+		s := secret.DeepCopy()
+		s.Data["password.previous"] = secret.Data["password"]
+		s.Data["salt.previous"] = secret.Data["salt"]
+		s.Data["algo.previous"] = secret.Data["algo"]
+		s.Data["cost.previous"] = secret.Data["cost"]
+		return s
+	}
+
+	t.Run("unexpired previous hash matches", func(t *testing.T) {
+		t.Parallel()
+
+		s := withPrevious()
+		s.Annotations = map[string]string{
+			previousExpiresAtAnnotation: time.Now().Add(time.Hour).Format(time.RFC3339),
+		}
+
+		ok, err := v.verifyPreviousHash(s)
+		if err != nil {
+			t.Fatalf("verifyPreviousHash: %v", err)
+		}
+		if !ok {
+			t.Error("verifyPreviousHash() = false, want true for an unexpired previous hash")
+		}
+	})
+
+	t.Run("expired previous hash is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		s := withPrevious()
+		s.Annotations = map[string]string{
+			previousExpiresAtAnnotation: time.Now().Add(-time.Hour).Format(time.RFC3339),
+		}
+
+		ok, err := v.verifyPreviousHash(s)
+		if err != nil {
+			t.Fatalf("verifyPreviousHash: %v", err)
+		}
+		if ok {
+			t.Error("verifyPreviousHash() = true, want false for an expired previous hash")
+		}
+	})
+
+	t.Run("missing expiry annotation is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		s := withPrevious()
+
+		ok, err := v.verifyPreviousHash(s)
+		if err != nil {
+			t.Fatalf("verifyPreviousHash: %v", err)
+		}
+		if ok {
+			t.Error("verifyPreviousHash() = true, want false when there's no expiry annotation")
+		}
+	})
+}
@@ -0,0 +1,61 @@
+// Package internal holds helpers shared by the engine-specific provision
+// commands.
+package internal
+
+import (
+	"encoding/json"
+
+	everestv1alpha "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/percona-everest-backend/client"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Resources stores the parsed CPU, memory and disk quantities for a
+// DatabaseCluster.
+type Resources struct {
+	CPU    resource.Quantity
+	Memory resource.Quantity
+	Disk   resource.Quantity
+}
+
+// ParseResources parses the CPU, memory and disk strings coming from the
+// command configuration into resource.Quantity values.
+func ParseResources(cpu, memory, disk string) (*Resources, error) {
+	cpuQ, err := resource.ParseQuantity(cpu)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse cpu")
+	}
+
+	memoryQ, err := resource.ParseQuantity(memory)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse memory")
+	}
+
+	diskQ, err := resource.ParseQuantity(disk)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse disk storage")
+	}
+
+	return &Resources{CPU: cpuQ, Memory: memoryQ, Disk: diskQ}, nil
+}
+
+// ConvertPayload round-trips an everestv1alpha.DatabaseCluster through JSON
+// into the shape expected by the Everest API client, logging the payload at
+// debug level on the way.
+func ConvertPayload(l *logrus.Entry, payload everestv1alpha.DatabaseCluster) (*client.DatabaseCluster, error) {
+	bodyJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal payload to json")
+	}
+
+	l.Debug(string(bodyJSON))
+
+	body := &client.DatabaseCluster{}
+	if err := json.Unmarshal(bodyJSON, body); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal payload back to json")
+	}
+
+	return body, nil
+}
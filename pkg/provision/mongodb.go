@@ -0,0 +1,137 @@
+package provision
+
+import (
+	"context"
+
+	everestv1alpha "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/percona-everest-backend/client"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-cli/pkg/provision/internal"
+)
+
+// MongoDB implements logic for the MongoDB command.
+type MongoDB struct {
+	config        *MongoDBConfig
+	everestClient everestClientConnector
+	l             *logrus.Entry
+}
+
+// MongoDBConfig stores configuration for the MongoDB command.
+type MongoDBConfig struct {
+	Name         string
+	KubernetesID string `mapstructure:"kubernetes-id"`
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	DB struct {
+		Version string
+	}
+
+	Nodes  int
+	CPU    string
+	Memory string
+	Disk   string
+
+	// Sharded enables a sharded topology, fronted by mongos, instead of a
+	// single replica set.
+	Sharded bool
+
+	ExternalAccess bool `mapstructure:"external-access"`
+}
+
+// NewMongoDB returns a new MongoDB struct.
+func NewMongoDB(c *MongoDBConfig, everestClient everestClientConnector) *MongoDB {
+	if c == nil {
+		logrus.Panic("MongoDBConfig is required")
+	}
+
+	cli := &MongoDB{
+		config:        c,
+		everestClient: everestClient,
+		l:             logrus.WithField("component", "provision/mongodb"),
+	}
+
+	return cli
+}
+
+// Run runs the MongoDB command.
+func (m *MongoDB) Run(ctx context.Context) error {
+	m.l.Info("Preparing cluster config")
+	body, err := m.prepareBody()
+	if err != nil {
+		return err
+	}
+
+	m.l.Infof("Creating %q database cluster", m.config.Name)
+	_, err = m.everestClient.CreateDBCluster(ctx, m.config.KubernetesID, *body)
+	if err != nil {
+		return err
+	}
+
+	m.l.Infof("Database cluster %q has been scheduled to Kubernetes", m.config.Name)
+
+	return nil
+}
+
+func (m *MongoDB) prepareBody() (*client.DatabaseCluster, error) {
+	res, err := internal.ParseResources(m.config.CPU, m.config.Memory, m.config.Disk)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := int32(m.config.Nodes)
+	version := m.config.DB.Version
+	if m.config.DB.Version == "latest" {
+		// An empty string means the operator uses the latest version
+		version = ""
+	}
+
+	proxyType := everestv1alpha.ProxyTypeMongos
+	if !m.config.Sharded {
+		// Unsharded replica sets are accessed directly, without a proxy layer.
+		proxyType = ""
+	}
+
+	payload := everestv1alpha.DatabaseCluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "everest.percona.com/v1alpha1",
+			Kind:       "DatabaseCluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: m.config.Name,
+		},
+		Spec: everestv1alpha.DatabaseClusterSpec{
+			Engine: everestv1alpha.Engine{
+				Type:     everestv1alpha.DatabaseEngineMongoDB,
+				Replicas: replicas,
+				Version:  version,
+				Storage: everestv1alpha.Storage{
+					Size: res.Disk,
+				},
+				Resources: everestv1alpha.Resources{
+					CPU:    res.CPU,
+					Memory: res.Memory,
+				},
+			},
+			Proxy: everestv1alpha.Proxy{
+				Type:     proxyType,
+				Replicas: &replicas,
+				Expose: everestv1alpha.Expose{
+					Type: everestv1alpha.ExposeTypeInternal,
+				},
+			},
+		},
+	}
+
+	if m.config.ExternalAccess {
+		m.l.Debug("Enabling external access")
+		payload.Spec.Proxy.Expose.Type = everestv1alpha.ExposeTypeExternal
+	}
+
+	return internal.ConvertPayload(m.l, payload)
+}
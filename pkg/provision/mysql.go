@@ -2,14 +2,13 @@ package provision
 
 import (
 	"context"
-	"encoding/json"
 
 	everestv1alpha "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/percona/percona-everest-backend/client"
-	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-cli/pkg/provision/internal"
 )
 
 // MySQL implements logic for the MySQL command.
@@ -76,19 +75,9 @@ func (m *MySQL) Run(ctx context.Context) error {
 }
 
 func (m *MySQL) prepareBody() (*client.DatabaseCluster, error) {
-	cpu, err := resource.ParseQuantity(m.config.CPU)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot parse cpu")
-	}
-
-	memory, err := resource.ParseQuantity(m.config.Memory)
+	res, err := internal.ParseResources(m.config.CPU, m.config.Memory, m.config.Disk)
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot parse memory")
-	}
-
-	disk, err := resource.ParseQuantity(m.config.Disk)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot parse disk storage")
+		return nil, err
 	}
 
 	replicas := int32(m.config.Nodes)
@@ -112,11 +101,11 @@ func (m *MySQL) prepareBody() (*client.DatabaseCluster, error) {
 				Replicas: replicas,
 				Version:  version,
 				Storage: everestv1alpha.Storage{
-					Size: disk,
+					Size: res.Disk,
 				},
 				Resources: everestv1alpha.Resources{
-					CPU:    cpu,
-					Memory: memory,
+					CPU:    res.CPU,
+					Memory: res.Memory,
 				},
 			},
 			Proxy: everestv1alpha.Proxy{
@@ -134,22 +123,5 @@ func (m *MySQL) prepareBody() (*client.DatabaseCluster, error) {
 		payload.Spec.Proxy.Expose.Type = everestv1alpha.ExposeTypeExternal
 	}
 
-	return m.convertPayload(payload)
-}
-
-func (m *MySQL) convertPayload(payload everestv1alpha.DatabaseCluster) (*client.DatabaseCluster, error) {
-	bodyJSON, err := json.Marshal(payload)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot marshal payload to json")
-	}
-
-	m.l.Debug(string(bodyJSON))
-
-	body := &client.DatabaseCluster{}
-	err = json.Unmarshal(bodyJSON, body)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot unmarshal payload back to json")
-	}
-
-	return body, nil
+	return internal.ConvertPayload(m.l, payload)
 }
\ No newline at end of file
@@ -0,0 +1,127 @@
+package provision
+
+import (
+	"context"
+
+	everestv1alpha "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/percona-everest-backend/client"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-cli/pkg/provision/internal"
+)
+
+// PostgreSQL implements logic for the PostgreSQL command.
+type PostgreSQL struct {
+	config        *PostgreSQLConfig
+	everestClient everestClientConnector
+	l             *logrus.Entry
+}
+
+// PostgreSQLConfig stores configuration for the PostgreSQL command.
+type PostgreSQLConfig struct {
+	Name         string
+	KubernetesID string `mapstructure:"kubernetes-id"`
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	DB struct {
+		Version string
+	}
+
+	Nodes  int
+	CPU    string
+	Memory string
+	Disk   string
+
+	ExternalAccess bool `mapstructure:"external-access"`
+}
+
+// NewPostgreSQL returns a new PostgreSQL struct.
+func NewPostgreSQL(c *PostgreSQLConfig, everestClient everestClientConnector) *PostgreSQL {
+	if c == nil {
+		logrus.Panic("PostgreSQLConfig is required")
+	}
+
+	cli := &PostgreSQL{
+		config:        c,
+		everestClient: everestClient,
+		l:             logrus.WithField("component", "provision/postgresql"),
+	}
+
+	return cli
+}
+
+// Run runs the PostgreSQL command.
+func (p *PostgreSQL) Run(ctx context.Context) error {
+	p.l.Info("Preparing cluster config")
+	body, err := p.prepareBody()
+	if err != nil {
+		return err
+	}
+
+	p.l.Infof("Creating %q database cluster", p.config.Name)
+	_, err = p.everestClient.CreateDBCluster(ctx, p.config.KubernetesID, *body)
+	if err != nil {
+		return err
+	}
+
+	p.l.Infof("Database cluster %q has been scheduled to Kubernetes", p.config.Name)
+
+	return nil
+}
+
+func (p *PostgreSQL) prepareBody() (*client.DatabaseCluster, error) {
+	res, err := internal.ParseResources(p.config.CPU, p.config.Memory, p.config.Disk)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := int32(p.config.Nodes)
+	version := p.config.DB.Version
+	if p.config.DB.Version == "latest" {
+		// An empty string means the operator uses the latest version
+		version = ""
+	}
+
+	payload := everestv1alpha.DatabaseCluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "everest.percona.com/v1alpha1",
+			Kind:       "DatabaseCluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: p.config.Name,
+		},
+		Spec: everestv1alpha.DatabaseClusterSpec{
+			Engine: everestv1alpha.Engine{
+				Type:     everestv1alpha.DatabaseEnginePostgresql,
+				Replicas: replicas,
+				Version:  version,
+				Storage: everestv1alpha.Storage{
+					Size: res.Disk,
+				},
+				Resources: everestv1alpha.Resources{
+					CPU:    res.CPU,
+					Memory: res.Memory,
+				},
+			},
+			Proxy: everestv1alpha.Proxy{
+				Type:     everestv1alpha.ProxyTypePGBouncer,
+				Replicas: &replicas,
+				Expose: everestv1alpha.Expose{
+					Type: everestv1alpha.ExposeTypeInternal,
+				},
+			},
+		},
+	}
+
+	if p.config.ExternalAccess {
+		p.l.Debug("Enabling external access")
+		payload.Spec.Proxy.Expose.Type = everestv1alpha.ExposeTypeExternal
+	}
+
+	return internal.ConvertPayload(p.l, payload)
+}
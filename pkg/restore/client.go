@@ -0,0 +1,13 @@
+package restore
+
+import (
+	"context"
+
+	"github.com/percona/percona-everest-backend/client"
+)
+
+// everestClientConnector exposes the subset of the Everest API client that
+// the restore command needs.
+type everestClientConnector interface {
+	CreateDBCluster(ctx context.Context, kubernetesID string, body client.DatabaseCluster) (*client.DatabaseCluster, error)
+}
@@ -0,0 +1,228 @@
+// Package restore holds the main logic for restore commands.
+package restore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	everestv1alpha "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/percona/percona-everest-backend/client"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-cli/pkg/kubernetes"
+)
+
+// pollInterval is an interval for checking the status of the restored
+// cluster. Run does not use it: it returns once CreateDBCluster succeeds,
+// without waiting for the cluster to become ready.
+const pollInterval = 5 * time.Second
+
+// MySQL implements logic for the restore command.
+type MySQL struct {
+	config        *MySQLConfig
+	everestClient everestClientConnector
+	kubeClient    *kubernetes.Kubernetes
+	l             *logrus.Entry
+}
+
+// MySQLConfig stores configuration for the restore command.
+type MySQLConfig struct {
+	Name         string
+	KubernetesID string `mapstructure:"kubernetes-id"`
+	// Namespace defines the namespace the cluster runs in. Only needed when
+	// Local is set.
+	Namespace string
+
+	Everest struct {
+		// Endpoint stores URL to Everest.
+		Endpoint string
+	}
+
+	// BackupName restores from a previously taken DatabaseClusterBackup.
+	// Mutually exclusive with BucketPath.
+	BackupName string `mapstructure:"backup-name"`
+	// BucketPath restores directly from an object-storage path rather than
+	// a known DatabaseClusterBackup.
+	BucketPath string `mapstructure:"bucket-path"`
+	// StorageSecretName points at the secret holding the object-storage
+	// credentials used to pull the dump. With Local set, the name is used as
+	// the rclone remote name and the secret is not read.
+	StorageSecretName string `mapstructure:"storage-secret-name"`
+
+	// PointInTime is a binlog position (or timestamp) to restore against.
+	PointInTime string `mapstructure:"point-in-time"`
+
+	// Local downloads BucketPath directly and restores it into the already
+	// running cluster Name via the mysql client, bypassing the
+	// operator-managed DatabaseCluster DataSource flow. Requires BucketPath.
+	Local bool
+}
+
+// NewMySQL returns a new MySQL struct.
+func NewMySQL(c *MySQLConfig, everestClient everestClientConnector, kubeClient *kubernetes.Kubernetes) *MySQL {
+	if c == nil {
+		logrus.Panic("MySQLConfig is required")
+	}
+
+	cli := &MySQL{
+		config:        c,
+		everestClient: everestClient,
+		kubeClient:    kubeClient,
+		l:             logrus.WithField("component", "restore/mysql"),
+	}
+
+	return cli
+}
+
+// Run runs the restore command.
+func (m *MySQL) Run(ctx context.Context) error {
+	if m.config.Local {
+		return m.runLocalRestore(ctx)
+	}
+
+	dataSource, err := m.prepareDataSource()
+	if err != nil {
+		return err
+	}
+
+	m.l.Infof("Restoring %q database cluster from %s", m.config.Name, dataSource.describe())
+
+	payload := m.preparePayload(dataSource)
+	if _, err := m.everestClient.CreateDBCluster(ctx, m.config.KubernetesID, *payload); err != nil {
+		return errors.Wrap(err, "cannot create database cluster")
+	}
+
+	m.l.Infof("Database cluster %q has been scheduled to Kubernetes", m.config.Name)
+
+	return nil
+}
+
+// runLocalRestore downloads BucketPath and restores it directly into the
+// already running cluster Name via the mysql client.
+func (m *MySQL) runLocalRestore(ctx context.Context) error {
+	if m.config.BucketPath == "" {
+		return errors.New("bucket-path is required for a local restore")
+	}
+
+	m.l.Infof("Restoring %q from bucket path %q", m.config.Name, m.config.BucketPath)
+
+	secret, err := m.kubeClient.GetSecret(ctx, m.config.Name+"-secrets", m.config.Namespace)
+	if err != nil {
+		return errors.Wrap(err, "cannot get user secret")
+	}
+
+	localPort, closeFn, err := m.kubeClient.PortForward(ctx, m.config.Namespace, m.config.Name, 3306)
+	if err != nil {
+		return errors.Wrap(err, "cannot port-forward to cluster")
+	}
+	defer closeFn()
+
+	remote := fmt.Sprintf("%s:%s", m.config.StorageSecretName, m.config.BucketPath)
+	restoreArgs := []string{"mysql", "-h", "127.0.0.1", "-P", fmt.Sprintf("%d", localPort), "-u", "root"}
+	restoreEnv := []string{"MYSQL_PWD=" + string(secret.Data["root"])}
+
+	if err := m.downloadDump(ctx, remote, restoreArgs, restoreEnv); err != nil {
+		return errors.Wrap(err, "cannot restore local dump")
+	}
+
+	m.l.Infof("%q has been restored from %q", m.config.Name, m.config.BucketPath)
+
+	return nil
+}
+
+// dataSource describes where the restore pulls its data from.
+type dataSource struct {
+	backupName  string
+	bucketPath  string
+	pointInTime string
+}
+
+func (d dataSource) describe() string {
+	if d.backupName != "" {
+		return fmt.Sprintf("backup %q", d.backupName)
+	}
+
+	return fmt.Sprintf("bucket path %q", d.bucketPath)
+}
+
+func (m *MySQL) prepareDataSource() (*dataSource, error) {
+	if m.config.BackupName == "" && m.config.BucketPath == "" {
+		return nil, errors.New("either backup-name or bucket-path is required")
+	}
+
+	if m.config.BackupName != "" && m.config.BucketPath != "" {
+		return nil, errors.New("backup-name and bucket-path are mutually exclusive")
+	}
+
+	return &dataSource{
+		backupName:  m.config.BackupName,
+		bucketPath:  m.config.BucketPath,
+		pointInTime: m.config.PointInTime,
+	}, nil
+}
+
+func (m *MySQL) preparePayload(ds *dataSource) *client.DatabaseCluster {
+	source := &everestv1alpha.DataSource{
+		PITR: everestv1alpha.PITR{
+			Date: ds.pointInTime,
+		},
+	}
+
+	if ds.backupName != "" {
+		source.DBClusterBackupName = ds.backupName
+	} else {
+		source.Path = ds.bucketPath
+		source.SecretName = m.config.StorageSecretName
+	}
+
+	return &client.DatabaseCluster{
+		Name:       m.config.Name,
+		DataSource: source,
+	}
+}
+
+// downloadDump pulls a gzip-compressed dump from the configured remote via
+// an rclone-style remote config and decompresses it on the fly. It is the
+// counterpart to backup.MySQL.streamDump for restores that bypass the
+// operator-managed DatabaseCluster DataSource flow. restoreEnv is appended to
+// the restore process's environment rather than being embedded in
+// restoreArgs, so credentials don't end up readable via ps(1) or
+// /proc/<pid>/cmdline.
+func (m *MySQL) downloadDump(ctx context.Context, remote string, restoreArgs, restoreEnv []string) error {
+	download := exec.CommandContext(ctx, "rclone", "cat", remote) //nolint:gosec
+	gunzip := exec.CommandContext(ctx, "gunzip")
+	restore := exec.CommandContext(ctx, restoreArgs[0], restoreArgs[1:]...) //nolint:gosec
+	restore.Env = append(os.Environ(), restoreEnv...)
+
+	var err error
+	gunzip.Stdin, err = download.StdoutPipe()
+	if err != nil {
+		return errors.Wrap(err, "cannot pipe downloader into gunzip")
+	}
+
+	restore.Stdin, err = gunzip.StdoutPipe()
+	if err != nil {
+		return errors.Wrap(err, "cannot pipe gunzip into restore command")
+	}
+
+	if err := restore.Start(); err != nil {
+		return errors.Wrap(err, "cannot start restore command")
+	}
+	if err := gunzip.Start(); err != nil {
+		return errors.Wrap(err, "cannot start gunzip")
+	}
+	if err := download.Run(); err != nil {
+		return errors.Wrap(err, "cannot run downloader")
+	}
+
+	if err := gunzip.Wait(); err != nil {
+		return errors.Wrap(err, "gunzip failed")
+	}
+
+	return errors.Wrap(restore.Wait(), "restore command failed")
+}